@@ -27,6 +27,14 @@ const (
 	WorkloadType_WORKLOAD_TYPE_UNSPECIFIED WorkloadType = 0
 	WorkloadType_DEPLOYMENT                WorkloadType = 1
 	WorkloadType_DAEMONSET                 WorkloadType = 2
+	WorkloadType_STATEFULSET               WorkloadType = 3
+	// CUSTOM is any workload kind which has a pod template,
+	// such as Argo Rollouts or OpenKruise CloneSets.
+	WorkloadType_CUSTOM WorkloadType = 4
+	// JOB and CRONJOB are debugged in a fresh debug job created from the
+	// original job template, the original job or cronjob is untouched.
+	WorkloadType_JOB     WorkloadType = 5
+	WorkloadType_CRONJOB WorkloadType = 6
 )
 
 // Enum value maps for WorkloadType.
@@ -35,11 +43,19 @@ var (
 		0: "WORKLOAD_TYPE_UNSPECIFIED",
 		1: "DEPLOYMENT",
 		2: "DAEMONSET",
+		3: "STATEFULSET",
+		4: "CUSTOM",
+		5: "JOB",
+		6: "CRONJOB",
 	}
 	WorkloadType_value = map[string]int32{
 		"WORKLOAD_TYPE_UNSPECIFIED": 0,
 		"DEPLOYMENT":                1,
 		"DAEMONSET":                 2,
+		"STATEFULSET":               3,
+		"CUSTOM":                    4,
+		"JOB":                       5,
+		"CRONJOB":                   6,
 	}
 )
 
@@ -168,6 +184,117 @@ func (DebugToolType) EnumDescriptor() ([]byte, []int) {
 	return file_app_app_proto_rawDescGZIP(), []int{2}
 }
 
+type DebugMode int32
+
+const (
+	DebugMode_DEBUG_MODE_UNSPECIFIED DebugMode = 0
+	// PATCH patches the command of the workload to debug, pods will be
+	// restarted.
+	DebugMode_PATCH DebugMode = 1
+	// EPHEMERAL attaches the debugger in an ephemeral container to the running
+	// process, the workload and pods are untouched.
+	DebugMode_EPHEMERAL DebugMode = 2
+	// CLONE debugs a sibling deployment cloned from the workload, the
+	// workload keeps serving.
+	DebugMode_CLONE DebugMode = 3
+)
+
+// Enum value maps for DebugMode.
+var (
+	DebugMode_name = map[int32]string{
+		0: "DEBUG_MODE_UNSPECIFIED",
+		1: "PATCH",
+		2: "EPHEMERAL",
+		3: "CLONE",
+	}
+	DebugMode_value = map[string]int32{
+		"DEBUG_MODE_UNSPECIFIED": 0,
+		"PATCH":                  1,
+		"EPHEMERAL":              2,
+		"CLONE":                  3,
+	}
+)
+
+func (x DebugMode) Enum() *DebugMode {
+	p := new(DebugMode)
+	*p = x
+	return p
+}
+
+func (x DebugMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DebugMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_app_app_proto_enumTypes[3].Descriptor()
+}
+
+func (DebugMode) Type() protoreflect.EnumType {
+	return &file_app_app_proto_enumTypes[3]
+}
+
+func (x DebugMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DebugMode.Descriptor instead.
+func (DebugMode) EnumDescriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{3}
+}
+
+type LaunchMode int32
+
+const (
+	LaunchMode_LAUNCH_MODE_UNSPECIFIED LaunchMode = 0
+	// EXEC kills the running process and relaunches the built binary under
+	// the debugger.
+	LaunchMode_EXEC LaunchMode = 1
+	// ATTACH attaches the debugger to the running process in the container,
+	// the in-memory state is kept.
+	LaunchMode_ATTACH LaunchMode = 2
+)
+
+// Enum value maps for LaunchMode.
+var (
+	LaunchMode_name = map[int32]string{
+		0: "LAUNCH_MODE_UNSPECIFIED",
+		1: "EXEC",
+		2: "ATTACH",
+	}
+	LaunchMode_value = map[string]int32{
+		"LAUNCH_MODE_UNSPECIFIED": 0,
+		"EXEC":                    1,
+		"ATTACH":                  2,
+	}
+)
+
+func (x LaunchMode) Enum() *LaunchMode {
+	p := new(LaunchMode)
+	*p = x
+	return p
+}
+
+func (x LaunchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LaunchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_app_app_proto_enumTypes[4].Descriptor()
+}
+
+func (LaunchMode) Type() protoreflect.EnumType {
+	return &file_app_app_proto_enumTypes[4]
+}
+
+func (x LaunchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LaunchMode.Descriptor instead.
+func (LaunchMode) EnumDescriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{4}
+}
+
 type IDEType int32
 
 const (
@@ -175,21 +302,45 @@ const (
 	IDEType_VS_CODE              IDEType = 1
 	IDEType_GOLAND               IDEType = 2
 	IDEType_CLION                IDEType = 3
+	IDEType_PYCHARM              IDEType = 4
+	IDEType_WEBSTORM             IDEType = 5
+	IDEType_INTELLIJ             IDEType = 6
+	IDEType_RIDER                IDEType = 7
+	IDEType_NEOVIM               IDEType = 8
+	IDEType_EMACS                IDEType = 9
+	IDEType_ZED                  IDEType = 10
+	IDEType_HELIX                IDEType = 11
 )
 
 // Enum value maps for IDEType.
 var (
 	IDEType_name = map[int32]string{
-		0: "IDE_TYPE_UNSPECIFIED",
-		1: "VS_CODE",
-		2: "GOLAND",
-		3: "CLION",
+		0:  "IDE_TYPE_UNSPECIFIED",
+		1:  "VS_CODE",
+		2:  "GOLAND",
+		3:  "CLION",
+		4:  "PYCHARM",
+		5:  "WEBSTORM",
+		6:  "INTELLIJ",
+		7:  "RIDER",
+		8:  "NEOVIM",
+		9:  "EMACS",
+		10: "ZED",
+		11: "HELIX",
 	}
 	IDEType_value = map[string]int32{
 		"IDE_TYPE_UNSPECIFIED": 0,
 		"VS_CODE":              1,
 		"GOLAND":               2,
 		"CLION":                3,
+		"PYCHARM":              4,
+		"WEBSTORM":             5,
+		"INTELLIJ":             6,
+		"RIDER":                7,
+		"NEOVIM":               8,
+		"EMACS":                9,
+		"ZED":                  10,
+		"HELIX":                11,
 	}
 )
 
@@ -204,11 +355,11 @@ func (x IDEType) String() string {
 }
 
 func (IDEType) Descriptor() protoreflect.EnumDescriptor {
-	return file_app_app_proto_enumTypes[3].Descriptor()
+	return file_app_app_proto_enumTypes[5].Descriptor()
 }
 
 func (IDEType) Type() protoreflect.EnumType {
-	return &file_app_app_proto_enumTypes[3]
+	return &file_app_app_proto_enumTypes[5]
 }
 
 func (x IDEType) Number() protoreflect.EnumNumber {
@@ -217,7 +368,63 @@ func (x IDEType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IDEType.Descriptor instead.
 func (IDEType) EnumDescriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{3}
+	return file_app_app_proto_rawDescGZIP(), []int{5}
+}
+
+type StopBehavior int32
+
+const (
+	// STOP_BEHAVIOR_UNSPECIFIED is the same as KEEP.
+	StopBehavior_STOP_BEHAVIOR_UNSPECIFIED StopBehavior = 0
+	// KEEP keeps the debugger running when the debug session ends.
+	StopBehavior_KEEP StopBehavior = 1
+	// STOP_DEBUGGER stops the debugger when the debug session ends.
+	StopBehavior_STOP_DEBUGGER StopBehavior = 2
+	// ROLLBACK rolls back the workload when the debug session ends.
+	StopBehavior_ROLLBACK StopBehavior = 3
+)
+
+// Enum value maps for StopBehavior.
+var (
+	StopBehavior_name = map[int32]string{
+		0: "STOP_BEHAVIOR_UNSPECIFIED",
+		1: "KEEP",
+		2: "STOP_DEBUGGER",
+		3: "ROLLBACK",
+	}
+	StopBehavior_value = map[string]int32{
+		"STOP_BEHAVIOR_UNSPECIFIED": 0,
+		"KEEP":                      1,
+		"STOP_DEBUGGER":             2,
+		"ROLLBACK":                  3,
+	}
+)
+
+func (x StopBehavior) Enum() *StopBehavior {
+	p := new(StopBehavior)
+	*p = x
+	return p
+}
+
+func (x StopBehavior) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopBehavior) Descriptor() protoreflect.EnumDescriptor {
+	return file_app_app_proto_enumTypes[6].Descriptor()
+}
+
+func (StopBehavior) Type() protoreflect.EnumType {
+	return &file_app_app_proto_enumTypes[6]
+}
+
+func (x StopBehavior) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StopBehavior.Descriptor instead.
+func (StopBehavior) EnumDescriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{6}
 }
 
 type ProgramType int32
@@ -226,6 +433,11 @@ const (
 	ProgramType_PROGRAM_TYPE_UNSPECIFIED ProgramType = 0
 	ProgramType_GO                       ProgramType = 1
 	ProgramType_RUST                     ProgramType = 2
+	ProgramType_PYTHON                   ProgramType = 3
+	ProgramType_NODE                     ProgramType = 4
+	ProgramType_JAVA                     ProgramType = 5
+	ProgramType_CPP                      ProgramType = 6
+	ProgramType_DOTNET                   ProgramType = 7
 )
 
 // Enum value maps for ProgramType.
@@ -234,11 +446,21 @@ var (
 		0: "PROGRAM_TYPE_UNSPECIFIED",
 		1: "GO",
 		2: "RUST",
+		3: "PYTHON",
+		4: "NODE",
+		5: "JAVA",
+		6: "CPP",
+		7: "DOTNET",
 	}
 	ProgramType_value = map[string]int32{
 		"PROGRAM_TYPE_UNSPECIFIED": 0,
 		"GO":                       1,
 		"RUST":                     2,
+		"PYTHON":                   3,
+		"NODE":                     4,
+		"JAVA":                     5,
+		"CPP":                      6,
+		"DOTNET":                   7,
 	}
 )
 
@@ -253,11 +475,11 @@ func (x ProgramType) String() string {
 }
 
 func (ProgramType) Descriptor() protoreflect.EnumDescriptor {
-	return file_app_app_proto_enumTypes[4].Descriptor()
+	return file_app_app_proto_enumTypes[7].Descriptor()
 }
 
 func (ProgramType) Type() protoreflect.EnumType {
-	return &file_app_app_proto_enumTypes[4]
+	return &file_app_app_proto_enumTypes[7]
 }
 
 func (x ProgramType) Number() protoreflect.EnumNumber {
@@ -266,7 +488,7 @@ func (x ProgramType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProgramType.Descriptor instead.
 func (ProgramType) EnumDescriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{4}
+	return file_app_app_proto_rawDescGZIP(), []int{7}
 }
 
 type RemoteRuntime struct {
@@ -277,7 +499,7 @@ type RemoteRuntime struct {
 	// Namespace is the namespace of the pod.
 	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	// WorkloadType is the type of workload.
-	// Such as deployment, statefulset etc.
+	// Such as deployment, statefulset, cronjob etc.
 	WorkloadType WorkloadType `protobuf:"varint,2,opt,name=workloadType,proto3,enum=miragedebug.api.app.WorkloadType" json:"workloadType,omitempty"`
 	// WorkloadName is the name of the workload.
 	WorkloadName string `protobuf:"bytes,3,opt,name=workloadName,proto3" json:"workloadName,omitempty"`
@@ -289,6 +511,18 @@ type RemoteRuntime struct {
 	// If your OS is amd64, but want to debug container in arm64,
 	// this should be set to "arm64".
 	TargetArch ArchType `protobuf:"varint,5,opt,name=targetArch,proto3,enum=miragedebug.api.app.ArchType" json:"targetArch,omitempty"`
+	// PodOrdinal is the ordinal of the pod to debug when WorkloadType is
+	// STATEFULSET, the pod <workloadName>-<podOrdinal> will be selected.
+	PodOrdinal int32 `protobuf:"varint,6,opt,name=podOrdinal,proto3" json:"podOrdinal,omitempty"`
+	// WorkloadApiVersion is the apiVersion of the workload when WorkloadType is
+	// CUSTOM. Such as argoproj.io/v1alpha1
+	WorkloadApiVersion string `protobuf:"bytes,7,opt,name=workloadApiVersion,proto3" json:"workloadApiVersion,omitempty"`
+	// WorkloadKind is the kind of the workload when WorkloadType is CUSTOM.
+	// Such as Rollout
+	WorkloadKind string `protobuf:"bytes,8,opt,name=workloadKind,proto3" json:"workloadKind,omitempty"`
+	// TemplatePath is the dot separated path of the pod template in a CUSTOM
+	// workload. Defaults to spec.template
+	TemplatePath string `protobuf:"bytes,9,opt,name=templatePath,proto3" json:"templatePath,omitempty"`
 }
 
 func (x *RemoteRuntime) Reset() {
@@ -358,6 +592,34 @@ func (x *RemoteRuntime) GetTargetArch() ArchType {
 	return ArchType_ARCH_TYPE_UNSPECIFIED
 }
 
+func (x *RemoteRuntime) GetPodOrdinal() int32 {
+	if x != nil {
+		return x.PodOrdinal
+	}
+	return 0
+}
+
+func (x *RemoteRuntime) GetWorkloadApiVersion() string {
+	if x != nil {
+		return x.WorkloadApiVersion
+	}
+	return ""
+}
+
+func (x *RemoteRuntime) GetWorkloadKind() string {
+	if x != nil {
+		return x.WorkloadKind
+	}
+	return ""
+}
+
+func (x *RemoteRuntime) GetTemplatePath() string {
+	if x != nil {
+		return x.TemplatePath
+	}
+	return ""
+}
+
 // DebugToolBuilder is the interface to build debug tool.
 type DebugToolBuilder struct {
 	state         protoimpl.MessageState
@@ -457,6 +719,25 @@ type RemoteConfig struct {
 	// NoModifyConfig indicates whether to modify the config of the workload.
 	// If true, we will not modify the config of the workload.
 	NoModifyConfig bool `protobuf:"varint,6,opt,name=noModifyConfig,proto3" json:"noModifyConfig,omitempty"`
+	// DebugMode is the way to debug the application, defaults to PATCH.
+	// NoModifyConfig is always true in EPHEMERAL mode.
+	DebugMode DebugMode `protobuf:"varint,7,opt,name=debugMode,proto3,enum=miragedebug.api.app.DebugMode" json:"debugMode,omitempty"`
+	// EphemeralImage is the image of the ephemeral debugger container in
+	// EPHEMERAL mode, it requires sh and tar. Defaults to busybox
+	EphemeralImage string `protobuf:"bytes,8,opt,name=ephemeralImage,proto3" json:"ephemeralImage,omitempty"`
+	// CloneKeepLabels indicates whether the clone keeps the labels of the
+	// workload pods in CLONE mode, so the clone is selected by the services of
+	// the workload and receives a share of the traffic.
+	CloneKeepLabels bool `protobuf:"varint,9,opt,name=cloneKeepLabels,proto3" json:"cloneKeepLabels,omitempty"`
+	// LaunchMode is the way to start debugging, defaults to EXEC.
+	// It is always ATTACH in EPHEMERAL mode.
+	LaunchMode LaunchMode `protobuf:"varint,10,opt,name=launchMode,proto3,enum=miragedebug.api.app.LaunchMode" json:"launchMode,omitempty"`
+	// AttachProcessName is the name of the process to attach in ATTACH mode.
+	// Empty means the entrypoint of the container (PID 1).
+	AttachProcessName string `protobuf:"bytes,11,opt,name=attachProcessName,proto3" json:"attachProcessName,omitempty"`
+	// ReadyTimeoutSeconds is the time to wait for the debugger to accept
+	// connections after starting debugging, defaults to 30 seconds.
+	ReadyTimeoutSeconds int32 `protobuf:"varint,12,opt,name=readyTimeoutSeconds,proto3" json:"readyTimeoutSeconds,omitempty"`
 }
 
 func (x *RemoteConfig) Reset() {
@@ -533,6 +814,48 @@ func (x *RemoteConfig) GetNoModifyConfig() bool {
 	return false
 }
 
+func (x *RemoteConfig) GetDebugMode() DebugMode {
+	if x != nil {
+		return x.DebugMode
+	}
+	return DebugMode_DEBUG_MODE_UNSPECIFIED
+}
+
+func (x *RemoteConfig) GetEphemeralImage() string {
+	if x != nil {
+		return x.EphemeralImage
+	}
+	return ""
+}
+
+func (x *RemoteConfig) GetCloneKeepLabels() bool {
+	if x != nil {
+		return x.CloneKeepLabels
+	}
+	return false
+}
+
+func (x *RemoteConfig) GetLaunchMode() LaunchMode {
+	if x != nil {
+		return x.LaunchMode
+	}
+	return LaunchMode_LAUNCH_MODE_UNSPECIFIED
+}
+
+func (x *RemoteConfig) GetAttachProcessName() string {
+	if x != nil {
+		return x.AttachProcessName
+	}
+	return ""
+}
+
+func (x *RemoteConfig) GetReadyTimeoutSeconds() int32 {
+	if x != nil {
+		return x.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
 type LocalConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -561,6 +884,15 @@ type LocalConfig struct {
 	BuildOutput string `protobuf:"bytes,7,opt,name=buildOutput,proto3" json:"buildOutput,omitempty"`
 	// Metadata is the metadata of the IDE or language, such as GO version.
 	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// IdeTypeName is the name of the IDE type provided by a plugin,
+	// it is used when IdeType is unspecified.
+	IdeTypeName string `protobuf:"bytes,9,opt,name=ideTypeName,proto3" json:"ideTypeName,omitempty"`
+	// SourceMappings maps the source paths recorded in the binary to the local source paths,
+	// such as the paths trimmed by -trimpath or built in a container.
+	// empty means they are the same.
+	SourceMappings []*SourceMapping `protobuf:"bytes,10,rep,name=sourceMappings,proto3" json:"sourceMappings,omitempty"`
+	// StopBehavior is what to do when the debug session in the IDE ends.
+	StopBehavior StopBehavior `protobuf:"varint,11,opt,name=stopBehavior,proto3,enum=miragedebug.api.app.StopBehavior" json:"stopBehavior,omitempty"`
 }
 
 func (x *LocalConfig) Reset() {
@@ -651,6 +983,86 @@ func (x *LocalConfig) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *LocalConfig) GetIdeTypeName() string {
+	if x != nil {
+		return x.IdeTypeName
+	}
+	return ""
+}
+
+func (x *LocalConfig) GetSourceMappings() []*SourceMapping {
+	if x != nil {
+		return x.SourceMappings
+	}
+	return nil
+}
+
+func (x *LocalConfig) GetStopBehavior() StopBehavior {
+	if x != nil {
+		return x.StopBehavior
+	}
+	return StopBehavior_STOP_BEHAVIOR_UNSPECIFIED
+}
+
+type SourceMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// LocalPath is the source path in local.
+	// Such as /Users/kebeliu/workspace/miragedebug
+	LocalPath string `protobuf:"bytes,1,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	// RemotePath is the source path recorded in the binary.
+	// Such as github.com/miragedebug/miragedebug or /src
+	RemotePath string `protobuf:"bytes,2,opt,name=remotePath,proto3" json:"remotePath,omitempty"`
+}
+
+func (x *SourceMapping) Reset() {
+	*x = SourceMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceMapping) ProtoMessage() {}
+
+func (x *SourceMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceMapping.ProtoReflect.Descriptor instead.
+func (*SourceMapping) Descriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SourceMapping) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *SourceMapping) GetRemotePath() string {
+	if x != nil {
+		return x.RemotePath
+	}
+	return ""
+}
+
 type App struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -666,12 +1078,15 @@ type App struct {
 	RemoteConfig *RemoteConfig `protobuf:"bytes,4,opt,name=remoteConfig,proto3" json:"remoteConfig,omitempty"`
 	// LocalConfig is the config to debug.
 	LocalConfig *LocalConfig `protobuf:"bytes,5,opt,name=localConfig,proto3" json:"localConfig,omitempty"`
+	// ProgramTypeName is the name of the program type provided by a plugin,
+	// it is used when ProgramType is unspecified.
+	ProgramTypeName string `protobuf:"bytes,6,opt,name=programTypeName,proto3" json:"programTypeName,omitempty"`
 }
 
 func (x *App) Reset() {
 	*x = App{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_proto_msgTypes[4]
+		mi := &file_app_app_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +1099,7 @@ func (x *App) String() string {
 func (*App) ProtoMessage() {}
 
 func (x *App) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_proto_msgTypes[4]
+	mi := &file_app_app_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +1112,7 @@ func (x *App) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use App.ProtoReflect.Descriptor instead.
 func (*App) Descriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{4}
+	return file_app_app_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *App) GetName() string {
@@ -735,6 +1150,13 @@ func (x *App) GetLocalConfig() *LocalConfig {
 	return nil
 }
 
+func (x *App) GetProgramTypeName() string {
+	if x != nil {
+		return x.ProgramTypeName
+	}
+	return ""
+}
+
 type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -754,12 +1176,15 @@ type Status struct {
 	// DebugToolPath is the path of the debug tool execute binary in container.
 	// Such as /tmp/dlv-amd64
 	DebugToolPath string `protobuf:"bytes,6,opt,name=debugToolPath,proto3" json:"debugToolPath,omitempty"`
+	// Event is the latest event of the app, such as the pod is replaced and
+	// the port-forward is rebound.
+	Event string `protobuf:"bytes,7,opt,name=event,proto3" json:"event,omitempty"`
 }
 
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_proto_msgTypes[5]
+		mi := &file_app_app_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -772,7 +1197,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_proto_msgTypes[5]
+	mi := &file_app_app_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,7 +1210,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{5}
+	return file_app_app_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Status) GetAppName() string {
@@ -830,6 +1255,13 @@ func (x *Status) GetDebugToolPath() string {
 	return ""
 }
 
+func (x *Status) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
 type SingleAppRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -841,7 +1273,7 @@ type SingleAppRequest struct {
 func (x *SingleAppRequest) Reset() {
 	*x = SingleAppRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_proto_msgTypes[6]
+		mi := &file_app_app_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -854,7 +1286,7 @@ func (x *SingleAppRequest) String() string {
 func (*SingleAppRequest) ProtoMessage() {}
 
 func (x *SingleAppRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_proto_msgTypes[6]
+	mi := &file_app_app_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1299,7 @@ func (x *SingleAppRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleAppRequest.ProtoReflect.Descriptor instead.
 func (*SingleAppRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{6}
+	return file_app_app_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SingleAppRequest) GetName() string {
@@ -877,6 +1309,203 @@ func (x *SingleAppRequest) GetName() string {
 	return ""
 }
 
+type StopDebuggingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// GracePeriodSeconds is the time to wait after SIGTERM before SIGKILL, 0 means the default 10 seconds.
+	GracePeriodSeconds int32 `protobuf:"varint,2,opt,name=gracePeriodSeconds,proto3" json:"gracePeriodSeconds,omitempty"`
+}
+
+func (x *StopDebuggingRequest) Reset() {
+	*x = StopDebuggingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopDebuggingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopDebuggingRequest) ProtoMessage() {}
+
+func (x *StopDebuggingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopDebuggingRequest.ProtoReflect.Descriptor instead.
+func (*StopDebuggingRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StopDebuggingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StopDebuggingRequest) GetGracePeriodSeconds() int32 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+type LogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Follow keeps streaming the new output, and reattaches to the new pod
+	// when the pod is restarted.
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// TailLines is the number of lines from the end to show, 0 means all.
+	TailLines int64 `protobuf:"varint,3,opt,name=tailLines,proto3" json:"tailLines,omitempty"`
+	// SinceSeconds shows the output newer than the relative duration.
+	SinceSeconds int64 `protobuf:"varint,4,opt,name=sinceSeconds,proto3" json:"sinceSeconds,omitempty"`
+	// Timestamps prefixes each line with its RFC3339 timestamp.
+	Timestamps bool `protobuf:"varint,5,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+}
+
+func (x *LogsRequest) Reset() {
+	*x = LogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsRequest) ProtoMessage() {}
+
+func (x *LogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
+func (*LogsRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LogsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *LogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *LogsRequest) GetSinceSeconds() int64 {
+	if x != nil {
+		return x.SinceSeconds
+	}
+	return 0
+}
+
+func (x *LogsRequest) GetTimestamps() bool {
+	if x != nil {
+		return x.Timestamps
+	}
+	return false
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PodName is the pod which the line is from.
+	PodName string `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	// Line is the output line without the line break.
+	Line string `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_app_app_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LogEntry) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
 type AppList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -888,7 +1517,7 @@ type AppList struct {
 func (x *AppList) Reset() {
 	*x = AppList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_proto_msgTypes[7]
+		mi := &file_app_app_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1530,7 @@ func (x *AppList) String() string {
 func (*AppList) ProtoMessage() {}
 
 func (x *AppList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_proto_msgTypes[7]
+	mi := &file_app_app_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1543,7 @@ func (x *AppList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppList.ProtoReflect.Descriptor instead.
 func (*AppList) Descriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{7}
+	return file_app_app_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AppList) GetApps() []*App {
@@ -933,7 +1562,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_proto_msgTypes[8]
+		mi := &file_app_app_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +1575,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_proto_msgTypes[8]
+	mi := &file_app_app_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1588,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{8}
+	return file_app_app_proto_rawDescGZIP(), []int{12}
 }
 
 type ServerInfo struct {
@@ -974,7 +1603,7 @@ type ServerInfo struct {
 func (x *ServerInfo) Reset() {
 	*x = ServerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_proto_msgTypes[9]
+		mi := &file_app_app_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -987,7 +1616,7 @@ func (x *ServerInfo) String() string {
 func (*ServerInfo) ProtoMessage() {}
 
 func (x *ServerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_proto_msgTypes[9]
+	mi := &file_app_app_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1629,7 @@ func (x *ServerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
 func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return file_app_app_proto_rawDescGZIP(), []int{9}
+	return file_app_app_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ServerInfo) GetVersion() string {
@@ -1024,7 +1653,7 @@ var file_app_app_proto_rawDesc = []byte{
 	0x13, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x61, 0x70, 0x70, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xfd, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x75, 0x6e,
+	0x74, 0x6f, 0x22, 0x95, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x75, 0x6e,
 	0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79,
@@ -1040,198 +1669,326 @@ var file_app_app_proto_rawDesc = []byte{
 	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65,
 	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x72,
 	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x72,
-	0x63, 0x68, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44,
-	0x65, 0x73, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f,
-	0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x70, 0x70,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65,
-	0x62, 0x75, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd1, 0x03, 0x0a, 0x0b, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x07, 0x69, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x72, 0x61,
-	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
-	0x49, 0x44, 0x45, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x69, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x51, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x72,
-	0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
-	0x72, 0x52, 0x10, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x44, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50,
-	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x41, 0x72,
-	0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x41, 0x72, 0x67,
-	0x73, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x02, 0x0a,
-	0x03, 0x41, 0x70, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x69,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12,
+	0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
 	0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0d,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x61, 0x70, 0x70, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x04, 0x0a, 0x0c,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6e, 0x6f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x3c, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x4b,
+	0x65, 0x65, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x3f, 0x0a, 0x0a, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x30, 0x0a, 0x13, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x86, 0x05, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x36, 0x0a, 0x07, 0x69, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x49, 0x44, 0x45, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x69, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54,
+	0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x10, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x70, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x41, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x4a, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d,
 	0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0xba, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x26,
-	0x0a, 0x10, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22,
-	0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70,
-	0x69, 0x64, 0x2a, 0x4c, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10,
-	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x45, 0x4d, 0x4f, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x02,
-	0x2a, 0x3b, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
-	0x41, 0x52, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4d, 0x44, 0x36, 0x34,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x4d, 0x36, 0x34, 0x10, 0x02, 0x2a, 0x47, 0x0a,
-	0x0d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
-	0x0a, 0x1b, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x4f, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50,
+	0x70, 0x70, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
+	0x0c, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4d, 0x0a, 0x0d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xdc, 0x02, 0x0a, 0x03, 0x41, 0x70,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x72,
+	0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x0b, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x28,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x10, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x9b, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x22, 0x38, 0x0a,
+	0x08, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x37, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x2a, 0x7f, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x45, 0x4d, 0x4f, 0x4e, 0x53, 0x45, 0x54, 0x10,
+	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54, 0x45, 0x46, 0x55, 0x4c, 0x53, 0x45, 0x54,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x04, 0x12, 0x07,
+	0x0a, 0x03, 0x4a, 0x4f, 0x42, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x4f, 0x4e, 0x4a,
+	0x4f, 0x42, 0x10, 0x06, 0x2a, 0x3b, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41,
+	0x4d, 0x44, 0x36, 0x34, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x4d, 0x36, 0x34, 0x10,
+	0x02, 0x2a, 0x47, 0x0a, 0x0d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x6f, 0x6f, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f, 0x54, 0x4f, 0x4f, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x09, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x45, 0x50, 0x48, 0x45, 0x4d, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x2a, 0x3f, 0x0a, 0x0a, 0x4c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x41, 0x55, 0x4e, 0x43, 0x48,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x58, 0x45, 0x43, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x10, 0x02, 0x2a, 0xa6, 0x01, 0x0a, 0x07, 0x49, 0x44,
+	0x45, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
-	0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x47, 0x0a, 0x07, 0x49, 0x44, 0x45, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x56,
-	0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4f, 0x4c, 0x41,
-	0x4e, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a,
-	0x3d, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x18, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x47, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x55, 0x53, 0x54, 0x10, 0x02, 0x32, 0xd8,
-	0x08, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x69, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e,
-	0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1b,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2d, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5a, 0x0a, 0x08, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0x58, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x1a, 0x18,
-	0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
-	0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
-	0x73, 0x12, 0x5f, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x18,
-	0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67,
-	0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41,
-	0x70, 0x70, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x1a, 0x13, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x12, 0x69, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12,
-	0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64,
+	0x0b, 0x0a, 0x07, 0x56, 0x53, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x47, 0x4f, 0x4c, 0x41, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x49, 0x4f,
+	0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x59, 0x43, 0x48, 0x41, 0x52, 0x4d, 0x10, 0x04,
+	0x12, 0x0c, 0x0a, 0x08, 0x57, 0x45, 0x42, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x10, 0x05, 0x12, 0x0c,
+	0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x4c, 0x4c, 0x49, 0x4a, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05,
+	0x52, 0x49, 0x44, 0x45, 0x52, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x45, 0x4f, 0x56, 0x49,
+	0x4d, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x43, 0x53, 0x10, 0x09, 0x12, 0x07,
+	0x0a, 0x03, 0x5a, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x45, 0x4c, 0x49, 0x58,
+	0x10, 0x0b, 0x2a, 0x58, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56,
+	0x49, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x45, 0x45, 0x50, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x54, 0x4f, 0x50, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x2a, 0x72, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x4f, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x55, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50,
+	0x59, 0x54, 0x48, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10,
+	0x04, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x41, 0x56, 0x41, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x43,
+	0x50, 0x50, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x4f, 0x54, 0x4e, 0x45, 0x54, 0x10, 0x07,
+	0x32, 0xda, 0x0c, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x69, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1f, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2d, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5a, 0x0a,
+	0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0x58, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64,
 	0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x66, 0x0a,
-	0x06, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65,
+	0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x70, 0x70, 0x73, 0x12, 0x5f, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x12, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x72,
+	0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x41, 0x70, 0x70, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x1a,
+	0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x12, 0x69, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67,
+	0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41,
+	0x70, 0x70, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
+	0x66, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x76, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65,
 	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
-	0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x76, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d,
-	0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7f, 0x0a,
-	0x0d, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x25,
+	0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
 	0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x2d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x7d,
-	0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x80, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x7a, 0x0a,
-	0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x12, 0x25, 0x2e, 0x6d,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x30, 0x01, 0x12, 0x74, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x12, 0x7f, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e,
+	0x69, 0x74, 0x2d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x7d, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x6d, 0x69,
+	0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x7d, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x53, 0x74, 0x6f, 0x70, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x6d,
 	0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61,
 	0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x2f, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x7a, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70,
+	0x12, 0x25, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22,
+	0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x2c, 0x5a,
+	0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x72, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x6d, 0x69, 0x72, 0x61, 0x67, 0x65, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1246,63 +2003,82 @@ func file_app_app_proto_rawDescGZIP() []byte {
 	return file_app_app_proto_rawDescData
 }
 
-var file_app_app_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_app_app_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_app_app_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_app_app_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_app_app_proto_goTypes = []interface{}{
-	(WorkloadType)(0),        // 0: miragedebug.api.app.WorkloadType
-	(ArchType)(0),            // 1: miragedebug.api.app.ArchType
-	(DebugToolType)(0),       // 2: miragedebug.api.app.DebugToolType
-	(IDEType)(0),             // 3: miragedebug.api.app.IDEType
-	(ProgramType)(0),         // 4: miragedebug.api.app.ProgramType
-	(*RemoteRuntime)(nil),    // 5: miragedebug.api.app.RemoteRuntime
-	(*DebugToolBuilder)(nil), // 6: miragedebug.api.app.DebugToolBuilder
-	(*RemoteConfig)(nil),     // 7: miragedebug.api.app.RemoteConfig
-	(*LocalConfig)(nil),      // 8: miragedebug.api.app.LocalConfig
-	(*App)(nil),              // 9: miragedebug.api.app.App
-	(*Status)(nil),           // 10: miragedebug.api.app.Status
-	(*SingleAppRequest)(nil), // 11: miragedebug.api.app.SingleAppRequest
-	(*AppList)(nil),          // 12: miragedebug.api.app.AppList
-	(*Empty)(nil),            // 13: miragedebug.api.app.Empty
-	(*ServerInfo)(nil),       // 14: miragedebug.api.app.ServerInfo
-	nil,                      // 15: miragedebug.api.app.LocalConfig.MetadataEntry
+	(WorkloadType)(0),            // 0: miragedebug.api.app.WorkloadType
+	(ArchType)(0),                // 1: miragedebug.api.app.ArchType
+	(DebugToolType)(0),           // 2: miragedebug.api.app.DebugToolType
+	(DebugMode)(0),               // 3: miragedebug.api.app.DebugMode
+	(LaunchMode)(0),              // 4: miragedebug.api.app.LaunchMode
+	(IDEType)(0),                 // 5: miragedebug.api.app.IDEType
+	(StopBehavior)(0),            // 6: miragedebug.api.app.StopBehavior
+	(ProgramType)(0),             // 7: miragedebug.api.app.ProgramType
+	(*RemoteRuntime)(nil),        // 8: miragedebug.api.app.RemoteRuntime
+	(*DebugToolBuilder)(nil),     // 9: miragedebug.api.app.DebugToolBuilder
+	(*RemoteConfig)(nil),         // 10: miragedebug.api.app.RemoteConfig
+	(*LocalConfig)(nil),          // 11: miragedebug.api.app.LocalConfig
+	(*SourceMapping)(nil),        // 12: miragedebug.api.app.SourceMapping
+	(*App)(nil),                  // 13: miragedebug.api.app.App
+	(*Status)(nil),               // 14: miragedebug.api.app.Status
+	(*SingleAppRequest)(nil),     // 15: miragedebug.api.app.SingleAppRequest
+	(*StopDebuggingRequest)(nil), // 16: miragedebug.api.app.StopDebuggingRequest
+	(*LogsRequest)(nil),          // 17: miragedebug.api.app.LogsRequest
+	(*LogEntry)(nil),             // 18: miragedebug.api.app.LogEntry
+	(*AppList)(nil),              // 19: miragedebug.api.app.AppList
+	(*Empty)(nil),                // 20: miragedebug.api.app.Empty
+	(*ServerInfo)(nil),           // 21: miragedebug.api.app.ServerInfo
+	nil,                          // 22: miragedebug.api.app.LocalConfig.MetadataEntry
 }
 var file_app_app_proto_depIdxs = []int32{
 	0,  // 0: miragedebug.api.app.RemoteRuntime.workloadType:type_name -> miragedebug.api.app.WorkloadType
 	1,  // 1: miragedebug.api.app.RemoteRuntime.targetArch:type_name -> miragedebug.api.app.ArchType
 	2,  // 2: miragedebug.api.app.DebugToolBuilder.type:type_name -> miragedebug.api.app.DebugToolType
-	3,  // 3: miragedebug.api.app.LocalConfig.ideType:type_name -> miragedebug.api.app.IDEType
-	6,  // 4: miragedebug.api.app.LocalConfig.debugToolBuilder:type_name -> miragedebug.api.app.DebugToolBuilder
-	15, // 5: miragedebug.api.app.LocalConfig.metadata:type_name -> miragedebug.api.app.LocalConfig.MetadataEntry
-	4,  // 6: miragedebug.api.app.App.programType:type_name -> miragedebug.api.app.ProgramType
-	5,  // 7: miragedebug.api.app.App.remoteRuntime:type_name -> miragedebug.api.app.RemoteRuntime
-	7,  // 8: miragedebug.api.app.App.remoteConfig:type_name -> miragedebug.api.app.RemoteConfig
-	8,  // 9: miragedebug.api.app.App.localConfig:type_name -> miragedebug.api.app.LocalConfig
-	9,  // 10: miragedebug.api.app.AppList.apps:type_name -> miragedebug.api.app.App
-	13, // 11: miragedebug.api.app.AppManagement.GetServerInfo:input_type -> miragedebug.api.app.Empty
-	13, // 12: miragedebug.api.app.AppManagement.ListApps:input_type -> miragedebug.api.app.Empty
-	9,  // 13: miragedebug.api.app.AppManagement.CreateApp:input_type -> miragedebug.api.app.App
-	9,  // 14: miragedebug.api.app.AppManagement.UpdateApp:input_type -> miragedebug.api.app.App
-	11, // 15: miragedebug.api.app.AppManagement.DeleteApp:input_type -> miragedebug.api.app.SingleAppRequest
-	11, // 16: miragedebug.api.app.AppManagement.GetApp:input_type -> miragedebug.api.app.SingleAppRequest
-	11, // 17: miragedebug.api.app.AppManagement.GetAppStatus:input_type -> miragedebug.api.app.SingleAppRequest
-	11, // 18: miragedebug.api.app.AppManagement.InitAppRemote:input_type -> miragedebug.api.app.SingleAppRequest
-	11, // 19: miragedebug.api.app.AppManagement.StartDebugging:input_type -> miragedebug.api.app.SingleAppRequest
-	11, // 20: miragedebug.api.app.AppManagement.RollbackApp:input_type -> miragedebug.api.app.SingleAppRequest
-	14, // 21: miragedebug.api.app.AppManagement.GetServerInfo:output_type -> miragedebug.api.app.ServerInfo
-	12, // 22: miragedebug.api.app.AppManagement.ListApps:output_type -> miragedebug.api.app.AppList
-	9,  // 23: miragedebug.api.app.AppManagement.CreateApp:output_type -> miragedebug.api.app.App
-	9,  // 24: miragedebug.api.app.AppManagement.UpdateApp:output_type -> miragedebug.api.app.App
-	9,  // 25: miragedebug.api.app.AppManagement.DeleteApp:output_type -> miragedebug.api.app.App
-	9,  // 26: miragedebug.api.app.AppManagement.GetApp:output_type -> miragedebug.api.app.App
-	10, // 27: miragedebug.api.app.AppManagement.GetAppStatus:output_type -> miragedebug.api.app.Status
-	10, // 28: miragedebug.api.app.AppManagement.InitAppRemote:output_type -> miragedebug.api.app.Status
-	13, // 29: miragedebug.api.app.AppManagement.StartDebugging:output_type -> miragedebug.api.app.Empty
-	10, // 30: miragedebug.api.app.AppManagement.RollbackApp:output_type -> miragedebug.api.app.Status
-	21, // [21:31] is the sub-list for method output_type
-	11, // [11:21] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 3: miragedebug.api.app.RemoteConfig.debugMode:type_name -> miragedebug.api.app.DebugMode
+	4,  // 4: miragedebug.api.app.RemoteConfig.launchMode:type_name -> miragedebug.api.app.LaunchMode
+	5,  // 5: miragedebug.api.app.LocalConfig.ideType:type_name -> miragedebug.api.app.IDEType
+	9,  // 6: miragedebug.api.app.LocalConfig.debugToolBuilder:type_name -> miragedebug.api.app.DebugToolBuilder
+	22, // 7: miragedebug.api.app.LocalConfig.metadata:type_name -> miragedebug.api.app.LocalConfig.MetadataEntry
+	12, // 8: miragedebug.api.app.LocalConfig.sourceMappings:type_name -> miragedebug.api.app.SourceMapping
+	6,  // 9: miragedebug.api.app.LocalConfig.stopBehavior:type_name -> miragedebug.api.app.StopBehavior
+	7,  // 10: miragedebug.api.app.App.programType:type_name -> miragedebug.api.app.ProgramType
+	8,  // 11: miragedebug.api.app.App.remoteRuntime:type_name -> miragedebug.api.app.RemoteRuntime
+	10, // 12: miragedebug.api.app.App.remoteConfig:type_name -> miragedebug.api.app.RemoteConfig
+	11, // 13: miragedebug.api.app.App.localConfig:type_name -> miragedebug.api.app.LocalConfig
+	13, // 14: miragedebug.api.app.AppList.apps:type_name -> miragedebug.api.app.App
+	20, // 15: miragedebug.api.app.AppManagement.GetServerInfo:input_type -> miragedebug.api.app.Empty
+	20, // 16: miragedebug.api.app.AppManagement.ListApps:input_type -> miragedebug.api.app.Empty
+	13, // 17: miragedebug.api.app.AppManagement.CreateApp:input_type -> miragedebug.api.app.App
+	13, // 18: miragedebug.api.app.AppManagement.UpdateApp:input_type -> miragedebug.api.app.App
+	15, // 19: miragedebug.api.app.AppManagement.DeleteApp:input_type -> miragedebug.api.app.SingleAppRequest
+	15, // 20: miragedebug.api.app.AppManagement.GetApp:input_type -> miragedebug.api.app.SingleAppRequest
+	15, // 21: miragedebug.api.app.AppManagement.GetAppStatus:input_type -> miragedebug.api.app.SingleAppRequest
+	15, // 22: miragedebug.api.app.AppManagement.WatchAppStatus:input_type -> miragedebug.api.app.SingleAppRequest
+	17, // 23: miragedebug.api.app.AppManagement.StreamAppLogs:input_type -> miragedebug.api.app.LogsRequest
+	15, // 24: miragedebug.api.app.AppManagement.InitAppRemote:input_type -> miragedebug.api.app.SingleAppRequest
+	15, // 25: miragedebug.api.app.AppManagement.StartDebugging:input_type -> miragedebug.api.app.SingleAppRequest
+	16, // 26: miragedebug.api.app.AppManagement.StopDebugging:input_type -> miragedebug.api.app.StopDebuggingRequest
+	15, // 27: miragedebug.api.app.AppManagement.RestartDebugging:input_type -> miragedebug.api.app.SingleAppRequest
+	15, // 28: miragedebug.api.app.AppManagement.RollbackApp:input_type -> miragedebug.api.app.SingleAppRequest
+	21, // 29: miragedebug.api.app.AppManagement.GetServerInfo:output_type -> miragedebug.api.app.ServerInfo
+	19, // 30: miragedebug.api.app.AppManagement.ListApps:output_type -> miragedebug.api.app.AppList
+	13, // 31: miragedebug.api.app.AppManagement.CreateApp:output_type -> miragedebug.api.app.App
+	13, // 32: miragedebug.api.app.AppManagement.UpdateApp:output_type -> miragedebug.api.app.App
+	13, // 33: miragedebug.api.app.AppManagement.DeleteApp:output_type -> miragedebug.api.app.App
+	13, // 34: miragedebug.api.app.AppManagement.GetApp:output_type -> miragedebug.api.app.App
+	14, // 35: miragedebug.api.app.AppManagement.GetAppStatus:output_type -> miragedebug.api.app.Status
+	14, // 36: miragedebug.api.app.AppManagement.WatchAppStatus:output_type -> miragedebug.api.app.Status
+	18, // 37: miragedebug.api.app.AppManagement.StreamAppLogs:output_type -> miragedebug.api.app.LogEntry
+	14, // 38: miragedebug.api.app.AppManagement.InitAppRemote:output_type -> miragedebug.api.app.Status
+	20, // 39: miragedebug.api.app.AppManagement.StartDebugging:output_type -> miragedebug.api.app.Empty
+	20, // 40: miragedebug.api.app.AppManagement.StopDebugging:output_type -> miragedebug.api.app.Empty
+	20, // 41: miragedebug.api.app.AppManagement.RestartDebugging:output_type -> miragedebug.api.app.Empty
+	14, // 42: miragedebug.api.app.AppManagement.RollbackApp:output_type -> miragedebug.api.app.Status
+	29, // [29:43] is the sub-list for method output_type
+	15, // [15:29] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_app_app_proto_init() }
@@ -1360,7 +2136,7 @@ func file_app_app_proto_init() {
 			}
 		}
 		file_app_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*App); i {
+			switch v := v.(*SourceMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,7 +2148,7 @@ func file_app_app_proto_init() {
 			}
 		}
 		file_app_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*App); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,7 +2160,7 @@ func file_app_app_proto_init() {
 			}
 		}
 		file_app_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleAppRequest); i {
+			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,7 +2172,7 @@ func file_app_app_proto_init() {
 			}
 		}
 		file_app_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppList); i {
+			switch v := v.(*SingleAppRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +2184,7 @@ func file_app_app_proto_init() {
 			}
 		}
 		file_app_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+			switch v := v.(*StopDebuggingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,6 +2196,54 @@ func file_app_app_proto_init() {
 			}
 		}
 		file_app_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerInfo); i {
 			case 0:
 				return &v.state
@@ -1437,8 +2261,8 @@ func file_app_app_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_app_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   11,
+			NumEnums:      8,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
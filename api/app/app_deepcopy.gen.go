@@ -89,6 +89,27 @@ func (in *LocalConfig) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using SourceMapping within kubernetes types, where deepcopy-gen is used.
+func (in *SourceMapping) DeepCopyInto(out *SourceMapping) {
+	p := proto.Clone(in).(*SourceMapping)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceMapping. Required by controller-gen.
+func (in *SourceMapping) DeepCopy() *SourceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new SourceMapping. Required by controller-gen.
+func (in *SourceMapping) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using App within kubernetes types, where deepcopy-gen is used.
 func (in *App) DeepCopyInto(out *App) {
 	p := proto.Clone(in).(*App)
@@ -152,6 +173,69 @@ func (in *SingleAppRequest) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using StopDebuggingRequest within kubernetes types, where deepcopy-gen is used.
+func (in *StopDebuggingRequest) DeepCopyInto(out *StopDebuggingRequest) {
+	p := proto.Clone(in).(*StopDebuggingRequest)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopDebuggingRequest. Required by controller-gen.
+func (in *StopDebuggingRequest) DeepCopy() *StopDebuggingRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(StopDebuggingRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new StopDebuggingRequest. Required by controller-gen.
+func (in *StopDebuggingRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using LogsRequest within kubernetes types, where deepcopy-gen is used.
+func (in *LogsRequest) DeepCopyInto(out *LogsRequest) {
+	p := proto.Clone(in).(*LogsRequest)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogsRequest. Required by controller-gen.
+func (in *LogsRequest) DeepCopy() *LogsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LogsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new LogsRequest. Required by controller-gen.
+func (in *LogsRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using LogEntry within kubernetes types, where deepcopy-gen is used.
+func (in *LogEntry) DeepCopyInto(out *LogEntry) {
+	p := proto.Clone(in).(*LogEntry)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEntry. Required by controller-gen.
+func (in *LogEntry) DeepCopy() *LogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new LogEntry. Required by controller-gen.
+func (in *LogEntry) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using AppList within kubernetes types, where deepcopy-gen is used.
 func (in *AppList) DeepCopyInto(out *AppList) {
 	p := proto.Clone(in).(*AppList)
@@ -29,17 +29,28 @@ type AppManagementClient interface {
 	DeleteApp(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*App, error)
 	GetApp(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*App, error)
 	GetAppStatus(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Status, error)
+	// WatchAppStatus streams the status of the app when it changes, such as
+	// the port-forward is disconnected or the pod is restarted.
+	WatchAppStatus(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (AppManagement_WatchAppStatusClient, error)
+	// StreamAppLogs streams the output of the app in container.
+	StreamAppLogs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (AppManagement_StreamAppLogsClient, error)
 	// InitAppRemote will do the following things:
 	//  1. config the workload to ready for debug.
 	//     a. change command and args.
 	//     b. change the replica to 1 and other things.
 	//  2. installing debug tool in container.
 	//  3. port-forward the remote debugging port.
+	// 4. watch the pods, the debug tool and the port-forward follow the replaced pod.
 	InitAppRemote(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Status, error)
 	// StartDebugging will do the following things:
 	// 1. copy the local binary to container.
 	// 2. start debug tool in container.
 	StartDebugging(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Empty, error)
+	// StopDebugging will stop the debug tool and the app started by it in container,
+	// only the process group started by MirageDebug is signaled.
+	StopDebugging(ctx context.Context, in *StopDebuggingRequest, opts ...grpc.CallOption) (*Empty, error)
+	// RestartDebugging will stop the running debugging and start it again.
+	RestartDebugging(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Empty, error)
 	// RollbackApp will rollback the app to the initial config.
 	RollbackApp(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Status, error)
 }
@@ -115,6 +126,70 @@ func (c *appManagementClient) GetAppStatus(ctx context.Context, in *SingleAppReq
 	return out, nil
 }
 
+func (c *appManagementClient) WatchAppStatus(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (AppManagement_WatchAppStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AppManagement_ServiceDesc.Streams[0], "/miragedebug.api.app.AppManagement/WatchAppStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appManagementWatchAppStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppManagement_WatchAppStatusClient interface {
+	Recv() (*Status, error)
+	grpc.ClientStream
+}
+
+type appManagementWatchAppStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *appManagementWatchAppStatusClient) Recv() (*Status, error) {
+	m := new(Status)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *appManagementClient) StreamAppLogs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (AppManagement_StreamAppLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AppManagement_ServiceDesc.Streams[1], "/miragedebug.api.app.AppManagement/StreamAppLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appManagementStreamAppLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppManagement_StreamAppLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
+}
+
+type appManagementStreamAppLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *appManagementStreamAppLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *appManagementClient) InitAppRemote(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, "/miragedebug.api.app.AppManagement/InitAppRemote", in, out, opts...)
@@ -133,6 +208,24 @@ func (c *appManagementClient) StartDebugging(ctx context.Context, in *SingleAppR
 	return out, nil
 }
 
+func (c *appManagementClient) StopDebugging(ctx context.Context, in *StopDebuggingRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/miragedebug.api.app.AppManagement/StopDebugging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appManagementClient) RestartDebugging(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/miragedebug.api.app.AppManagement/RestartDebugging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appManagementClient) RollbackApp(ctx context.Context, in *SingleAppRequest, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, "/miragedebug.api.app.AppManagement/RollbackApp", in, out, opts...)
@@ -153,17 +246,28 @@ type AppManagementServer interface {
 	DeleteApp(context.Context, *SingleAppRequest) (*App, error)
 	GetApp(context.Context, *SingleAppRequest) (*App, error)
 	GetAppStatus(context.Context, *SingleAppRequest) (*Status, error)
+	// WatchAppStatus streams the status of the app when it changes, such as
+	// the port-forward is disconnected or the pod is restarted.
+	WatchAppStatus(*SingleAppRequest, AppManagement_WatchAppStatusServer) error
+	// StreamAppLogs streams the output of the app in container.
+	StreamAppLogs(*LogsRequest, AppManagement_StreamAppLogsServer) error
 	// InitAppRemote will do the following things:
 	//  1. config the workload to ready for debug.
 	//     a. change command and args.
 	//     b. change the replica to 1 and other things.
 	//  2. installing debug tool in container.
 	//  3. port-forward the remote debugging port.
+	// 4. watch the pods, the debug tool and the port-forward follow the replaced pod.
 	InitAppRemote(context.Context, *SingleAppRequest) (*Status, error)
 	// StartDebugging will do the following things:
 	// 1. copy the local binary to container.
 	// 2. start debug tool in container.
 	StartDebugging(context.Context, *SingleAppRequest) (*Empty, error)
+	// StopDebugging will stop the debug tool and the app started by it in container,
+	// only the process group started by MirageDebug is signaled.
+	StopDebugging(context.Context, *StopDebuggingRequest) (*Empty, error)
+	// RestartDebugging will stop the running debugging and start it again.
+	RestartDebugging(context.Context, *SingleAppRequest) (*Empty, error)
 	// RollbackApp will rollback the app to the initial config.
 	RollbackApp(context.Context, *SingleAppRequest) (*Status, error)
 	mustEmbedUnimplementedAppManagementServer()
@@ -194,12 +298,24 @@ func (UnimplementedAppManagementServer) GetApp(context.Context, *SingleAppReques
 func (UnimplementedAppManagementServer) GetAppStatus(context.Context, *SingleAppRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppStatus not implemented")
 }
+func (UnimplementedAppManagementServer) WatchAppStatus(*SingleAppRequest, AppManagement_WatchAppStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAppStatus not implemented")
+}
+func (UnimplementedAppManagementServer) StreamAppLogs(*LogsRequest, AppManagement_StreamAppLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAppLogs not implemented")
+}
 func (UnimplementedAppManagementServer) InitAppRemote(context.Context, *SingleAppRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitAppRemote not implemented")
 }
 func (UnimplementedAppManagementServer) StartDebugging(context.Context, *SingleAppRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartDebugging not implemented")
 }
+func (UnimplementedAppManagementServer) StopDebugging(context.Context, *StopDebuggingRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopDebugging not implemented")
+}
+func (UnimplementedAppManagementServer) RestartDebugging(context.Context, *SingleAppRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartDebugging not implemented")
+}
 func (UnimplementedAppManagementServer) RollbackApp(context.Context, *SingleAppRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RollbackApp not implemented")
 }
@@ -342,6 +458,48 @@ func _AppManagement_GetAppStatus_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManagement_WatchAppStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SingleAppRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppManagementServer).WatchAppStatus(m, &appManagementWatchAppStatusServer{stream})
+}
+
+type AppManagement_WatchAppStatusServer interface {
+	Send(*Status) error
+	grpc.ServerStream
+}
+
+type appManagementWatchAppStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *appManagementWatchAppStatusServer) Send(m *Status) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AppManagement_StreamAppLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppManagementServer).StreamAppLogs(m, &appManagementStreamAppLogsServer{stream})
+}
+
+type AppManagement_StreamAppLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
+}
+
+type appManagementStreamAppLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *appManagementStreamAppLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AppManagement_InitAppRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SingleAppRequest)
 	if err := dec(in); err != nil {
@@ -378,6 +536,42 @@ func _AppManagement_StartDebugging_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppManagement_StopDebugging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopDebuggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagementServer).StopDebugging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miragedebug.api.app.AppManagement/StopDebugging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagementServer).StopDebugging(ctx, req.(*StopDebuggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppManagement_RestartDebugging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppManagementServer).RestartDebugging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miragedebug.api.app.AppManagement/RestartDebugging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppManagementServer).RestartDebugging(ctx, req.(*SingleAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppManagement_RollbackApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SingleAppRequest)
 	if err := dec(in); err != nil {
@@ -439,11 +633,30 @@ var AppManagement_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartDebugging",
 			Handler:    _AppManagement_StartDebugging_Handler,
 		},
+		{
+			MethodName: "StopDebugging",
+			Handler:    _AppManagement_StopDebugging_Handler,
+		},
+		{
+			MethodName: "RestartDebugging",
+			Handler:    _AppManagement_RestartDebugging_Handler,
+		},
 		{
 			MethodName: "RollbackApp",
 			Handler:    _AppManagement_RollbackApp_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAppStatus",
+			Handler:       _AppManagement_WatchAppStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAppLogs",
+			Handler:       _AppManagement_StreamAppLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "app/app.proto",
 }
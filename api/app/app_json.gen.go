@@ -50,6 +50,17 @@ func (this *LocalConfig) UnmarshalJSON(b []byte) error {
 	return AppUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for SourceMapping
+func (this *SourceMapping) MarshalJSON() ([]byte, error) {
+	str, err := AppMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for SourceMapping
+func (this *SourceMapping) UnmarshalJSON(b []byte) error {
+	return AppUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 // MarshalJSON is a custom marshaler for App
 func (this *App) MarshalJSON() ([]byte, error) {
 	str, err := AppMarshaler.MarshalToString(this)
@@ -83,6 +94,39 @@ func (this *SingleAppRequest) UnmarshalJSON(b []byte) error {
 	return AppUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for StopDebuggingRequest
+func (this *StopDebuggingRequest) MarshalJSON() ([]byte, error) {
+	str, err := AppMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for StopDebuggingRequest
+func (this *StopDebuggingRequest) UnmarshalJSON(b []byte) error {
+	return AppUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for LogsRequest
+func (this *LogsRequest) MarshalJSON() ([]byte, error) {
+	str, err := AppMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for LogsRequest
+func (this *LogsRequest) UnmarshalJSON(b []byte) error {
+	return AppUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for LogEntry
+func (this *LogEntry) MarshalJSON() ([]byte, error) {
+	str, err := AppMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for LogEntry
+func (this *LogEntry) UnmarshalJSON(b []byte) error {
+	return AppUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 // MarshalJSON is a custom marshaler for AppList
 func (this *AppList) MarshalJSON() ([]byte, error) {
 	str, err := AppMarshaler.MarshalToString(this)
@@ -9,3 +9,21 @@ func ToSystemArch(arch ArchType) string {
 	}
 	return ""
 }
+
+// ProgramTypeName returns the name of the program type of the app,
+// the plugin provided name is used when ProgramType is unspecified.
+func ProgramTypeName(a *App) string {
+	if a.ProgramType == ProgramType_PROGRAM_TYPE_UNSPECIFIED && a.ProgramTypeName != "" {
+		return a.ProgramTypeName
+	}
+	return a.ProgramType.String()
+}
+
+// IDETypeName returns the name of the IDE type of the app,
+// the plugin provided name is used when IdeType is unspecified.
+func IDETypeName(a *App) string {
+	if a.GetLocalConfig().GetIdeType() == IDEType_IDE_TYPE_UNSPECIFIED && a.GetLocalConfig().GetIdeTypeName() != "" {
+		return a.LocalConfig.IdeTypeName
+	}
+	return a.GetLocalConfig().GetIdeType().String()
+}
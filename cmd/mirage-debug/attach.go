@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+func attachCmd() *cobra.Command {
+	stop := false
+	c := &cobra.Command{
+		Use:   "attach",
+		Short: "start debug and attach a local debugger client in the terminal",
+		Example: `
+	mirage-debug attach a
+	mirage-debug attach a --stop
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				log.Fatalf("please specify the project name")
+				return nil
+			}
+			checkOrInitServerCommand()
+			appName := args[0]
+			conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				log.Fatalf("did not connect: %v", err)
+				return nil
+			}
+			defer conn.Close()
+			c := app.NewAppManagementClient(conn)
+			if err := startDebug(c, appName); err != nil {
+				log.Fatalf("start debug failed: %v", err)
+				return nil
+			}
+			app_, err := c.GetApp(context.Background(), &app.SingleAppRequest{
+				Name: appName,
+			})
+			if err != nil {
+				log.Fatalf("get app failed: %v", err)
+				return nil
+			}
+			if err := runDebuggerClient(app_); err != nil {
+				log.Errorf("debugger client exited: %v", err)
+			}
+			if stop {
+				// the server stops the process group of the debugger with the default grace period.
+				if _, err := c.StopDebugging(context.Background(), &app.StopDebuggingRequest{Name: appName}); err != nil {
+					log.Fatalf("stop remote debugger failed: %v", err)
+				}
+			}
+			return nil
+		},
+	}
+	c.PersistentFlags().BoolVarP(&stop, "stop", "", false, "Stop the remote debugger when the client exits")
+
+	return c
+}
+
+func debuggerAddress(a *app.App) string {
+	return fmt.Sprintf("127.0.0.1:%d", a.RemoteConfig.RemoteDebuggingPort)
+}
+
+func localBinary(a *app.App) string {
+	if path.IsAbs(a.LocalConfig.BuildOutput) {
+		return a.LocalConfig.BuildOutput
+	}
+	return path.Join(a.LocalConfig.WorkingDir, a.LocalConfig.BuildOutput)
+}
+
+func gdbPath(a *app.App) string {
+	if p := a.LocalConfig.Metadata["gdbpath"]; p != "" {
+		return p
+	}
+	return "gdb"
+}
+
+func debuggerClientCommand(a *app.App) (*exec.Cmd, error) {
+	mappings := langadaptors.SourceMappings(a)
+	switch a.ProgramType {
+	case app.ProgramType_GO:
+		args := []string{"connect", debuggerAddress(a)}
+		if len(mappings) > 0 {
+			init, err := dlvInitFile(a, mappings)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, "--init", init)
+		}
+		return exec.Command("dlv", args...), nil
+	case app.ProgramType_RUST, app.ProgramType_CPP:
+		var args []string
+		for _, m := range mappings {
+			args = append(args, "-ex", fmt.Sprintf("set substitute-path %s %s", m.RemotePath, m.LocalPath))
+		}
+		args = append(args, "-ex", "target remote "+debuggerAddress(a), localBinary(a))
+		return exec.Command(gdbPath(a), args...), nil
+	default:
+		return nil, fmt.Errorf("attach does not support program type %s yet, please use the IDE", app.ProgramTypeName(a))
+	}
+}
+
+// dlvInitFile writes the substitute-path commands executed by dlv connect.
+func dlvInitFile(a *app.App, mappings []*app.SourceMapping) (string, error) {
+	var commands []string
+	for _, m := range mappings {
+		commands = append(commands, fmt.Sprintf("config substitute-path %s %s", m.RemotePath, m.LocalPath))
+	}
+	f := path.Join(os.TempDir(), fmt.Sprintf("mirage-dlv-init-%s", a.Name))
+	return f, os.WriteFile(f, []byte(strings.Join(commands, "\n")+"\n"), 0644)
+}
+
+func runDebuggerClient(a *app.App) error {
+	cmd, err := debuggerClientCommand(a)
+	if err != nil {
+		return err
+	}
+	cmd.Dir = a.LocalConfig.WorkingDir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	// ctrl-c interrupts the debuggee by the client, instead of exiting MirageDebug.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+	log.Debugf("debugger client command: %s", cmd.String())
+	return cmd.Run()
+}
@@ -9,15 +9,20 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/miragedebug/miragedebug/api/app"
-	"github.com/miragedebug/miragedebug/internal/ide-adapotors/jetbrains"
-	"github.com/miragedebug/miragedebug/internal/ide-adapotors/vscode"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/all"
 	"github.com/miragedebug/miragedebug/pkg/log"
 )
 
 func configCmd() *cobra.Command {
+	remove := false
 	c := &cobra.Command{
 		Use:   "config",
 		Short: "Initialize project ide config",
+		Example: `
+	mirage-debug config a
+	mirage-debug config a --remove
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				log.Fatalf("please specify the project name")
@@ -31,12 +36,19 @@ func configCmd() *cobra.Command {
 			}
 			defer conn.Close()
 			c := app.NewAppManagementClient(conn)
+			if remove {
+				if err := cleanupLocalConfig(c, appName); err != nil {
+					log.Fatalf("remove local config failed: %v", err)
+				}
+				return nil
+			}
 			if err := initLocalConfig(c, appName); err != nil {
 				log.Fatalf("init local config failed: %v", err)
 			}
 			return nil
 		},
 	}
+	c.PersistentFlags().BoolVarP(&remove, "remove", "", false, "Remove the ide config created by MirageDebug")
 
 	return c
 }
@@ -64,20 +76,30 @@ func initLocalConfig(client app.AppManagementClient, appName string) error {
 	if err != nil {
 		return err
 	}
-	switch app_.LocalConfig.IdeType {
-	case app.IDEType_GOLAND, app.IDEType_CLION:
-		j := jetbrains.NewJetbrainsAdaptor()
-		if err := j.PrepareLaunch(app_); err != nil {
-			return err
-		}
-	case app.IDEType_VS_CODE:
-		vs := vscode.NewVSCodeAdaptor()
-		if err := vs.PrepareLaunch(app_); err != nil {
-			return err
-		}
-	default:
-		return fmt.Errorf("ide type %s not supported", app_.LocalConfig.IdeType)
+	ide, err := ideadapotors.NewIDEAdaptor(app_)
+	if err != nil {
+		return err
+	}
+	if err := ide.PrepareLaunch(app_); err != nil {
+		return err
 	}
 	log.Debugf("remote init result: %s", s)
 	return nil
 }
+
+func cleanupLocalConfig(client app.AppManagementClient, appName string) error {
+	app_, err := client.GetApp(context.Background(), &app.SingleAppRequest{
+		Name: appName,
+	})
+	if err != nil {
+		return err
+	}
+	if app_.LocalConfig == nil {
+		return nil
+	}
+	ide, err := ideadapotors.NewIDEAdaptor(app_)
+	if err != nil {
+		return err
+	}
+	return ide.Cleanup(app_)
+}
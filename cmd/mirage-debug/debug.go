@@ -12,8 +12,7 @@ import (
 
 	"github.com/miragedebug/miragedebug/api/app"
 	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
-	"github.com/miragedebug/miragedebug/internal/lang-adaptors/golang"
-	"github.com/miragedebug/miragedebug/internal/lang-adaptors/rust"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/all"
 	"github.com/miragedebug/miragedebug/pkg/log"
 	"github.com/miragedebug/miragedebug/pkg/shell"
 )
@@ -47,14 +46,9 @@ func debugCmd() *cobra.Command {
 }
 
 func buildBinary(app_ *app.App) error {
-	var langAdaptor langadaptors.LanguageAdaptor
-	switch app_.ProgramType {
-	case app.ProgramType_GO:
-		langAdaptor = golang.NewGolangAdaptor()
-	case app.ProgramType_RUST:
-		langAdaptor = rust.NewRustAdaptor()
-	default:
-		return fmt.Errorf("program type %s not supported", app_.ProgramType)
+	langAdaptor, err := langadaptors.NewLanguageAdaptor(app_)
+	if err != nil {
+		return err
 	}
 	cmd, err := langAdaptor.BuildCommand(app_)
 	if err != nil {
@@ -99,8 +93,11 @@ func startDebug(client app.AppManagementClient, appName string) error {
 	if !s.Connected {
 		return fmt.Errorf("app %s not connected", appName)
 	}
-	if err := buildBinary(app_); err != nil {
-		return err
+	// the running process is attached, nothing to build.
+	if app_.GetRemoteConfig().GetDebugMode() != app.DebugMode_EPHEMERAL && app_.GetRemoteConfig().GetLaunchMode() != app.LaunchMode_ATTACH {
+		if err := buildBinary(app_); err != nil {
+			return err
+		}
 	}
 	_, err = client.StartDebugging(context.Background(), &app.SingleAppRequest{
 		Name: appName,
@@ -30,6 +30,9 @@ func deleteCmd() *cobra.Command {
 			}
 			defer conn.Close()
 			c := app.NewAppManagementClient(conn)
+			if err := cleanupLocalConfig(c, appName); err != nil {
+				log.Errorf("Remove local config failed: %v", err)
+			}
 			_, err = c.RollbackApp(context.Background(), &app.SingleAppRequest{
 				Name: appName,
 			})
@@ -74,7 +74,7 @@ func printApps(apps []*app.App, format string) error {
 		tableWriter := "%-20s%-10s%-10s%-50s\n"
 		fmt.Printf(tableWriter, "NAME", "LANGUAGE", "IDE", "WORKDIR")
 		for _, a := range apps {
-			fmt.Printf(tableWriter, a.Name, a.ProgramType, a.LocalConfig.IdeType, a.LocalConfig.WorkingDir)
+			fmt.Printf(tableWriter, a.Name, app.ProgramTypeName(a), app.IDETypeName(a), a.LocalConfig.WorkingDir)
 		}
 	case "yaml":
 		for _, a := range apps {
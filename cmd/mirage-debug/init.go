@@ -4,10 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
-	"path"
-	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -16,14 +14,21 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/yaml"
 
 	"github.com/miragedebug/miragedebug/api/app"
 	"github.com/miragedebug/miragedebug/config"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/all"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/all"
+	"github.com/miragedebug/miragedebug/internal/workloads"
 	"github.com/miragedebug/miragedebug/pkg/log"
 )
 
@@ -47,7 +52,7 @@ func initCmd() *cobra.Command {
 			}
 			kubeClient := kubernetes.NewForConfigOrDie(cfg)
 			c := app.NewAppManagementClient(conn)
-			if err := promptToCreateApp(c, kubeClient, answers); err != nil {
+			if err := promptToCreateApp(c, kubeClient, cfg, answers); err != nil {
 				log.Fatalf("create app failed: %v", err)
 			}
 			return nil
@@ -59,10 +64,17 @@ func initCmd() *cobra.Command {
 	c.PersistentFlags().StringVarP(&answers.Language, "language", "", "", "Programming language")
 	c.PersistentFlags().StringVarP(&answers.Namespace, "namespace", "", "", "App running namespace")
 	c.PersistentFlags().StringVarP(&answers.WorkloadType, "workload-type", "", "", "App workload type")
+	c.PersistentFlags().StringVarP(&answers.WorkloadApiVersion, "workload-api-version", "", "", "App workload apiVersion, only for custom workload")
+	c.PersistentFlags().StringVarP(&answers.WorkloadKind, "workload-kind", "", "", "App workload kind, only for custom workload")
 	c.PersistentFlags().StringVarP(&answers.Workload, "workload", "", "", "App workload name")
+	c.PersistentFlags().StringVarP(&answers.DebugMode, "debug-mode", "", "", "Debug mode, PATCH, EPHEMERAL or CLONE")
+	c.PersistentFlags().StringVarP(&answers.LaunchMode, "launch-mode", "", "", "Launch mode, EXEC or ATTACH")
+	c.PersistentFlags().StringVarP(&answers.AttachProcess, "attach-process", "", "", "Name of the process to attach, defaults to the container entrypoint")
+	c.PersistentFlags().StringVarP(&answers.PodOrdinal, "pod-ordinal", "", "", "Pod ordinal of the statefulset")
 	c.PersistentFlags().StringVarP(&answers.Container, "container", "", "", "App Container")
 	c.PersistentFlags().StringVarP(&answers.RemoteArch, "remote-arch", "", "", "App Arch")
 	c.PersistentFlags().StringVarP(&answers.IDE, "ide", "", "", "IDE type")
+	c.PersistentFlags().StringVarP(&answers.StopBehavior, "stop-behavior", "", "", "What to do when the debug session ends, KEEP, STOP_DEBUGGER or ROLLBACK")
 	c.PersistentFlags().StringVarP(&answers.Workdir, "workdir", "", "", "Source code path")
 	c.PersistentFlags().StringVarP(&answers.AppEntry, "app-entry", "", "", "Entry path of the app, relative to the workdir")
 	c.PersistentFlags().StringVarP(&answers.BuildCommand, "build-command", "", "", "How to build the app")
@@ -73,21 +85,29 @@ func initCmd() *cobra.Command {
 }
 
 type initAnswer struct {
-	Name         string
-	Language     string
-	Namespace    string
-	WorkloadType string
-	Workload     string
-	Container    string
-	RemoteArch   string
-	IDE          string
-	Workdir      string
-	AppEntry     string
-	BuildCommand string
-	BuildOutput  string
-	RunArgs      string
-	CustomConfig bool
-	Config       string
+	Name               string
+	Language           string
+	Namespace          string
+	WorkloadType       string
+	WorkloadApiVersion string
+	WorkloadKind       string
+	Workload           string
+	DebugMode          string
+	CloneKeepLabels    bool
+	LaunchMode         string
+	AttachProcess      string
+	PodOrdinal         string
+	Container          string
+	RemoteArch         string
+	IDE                string
+	StopBehavior       string
+	Workdir            string
+	AppEntry           string
+	BuildCommand       string
+	BuildOutput        string
+	RunArgs            string
+	CustomConfig       bool
+	Config             string
 }
 
 func (answers *initAnswer) archType() app.ArchType {
@@ -97,29 +117,65 @@ func (answers *initAnswer) archType() app.ArchType {
 	return app.ArchType_AMD64
 }
 
+func (answers *initAnswer) podOrdinal() (int32, error) {
+	if answers.PodOrdinal == "" {
+		return 0, nil
+	}
+	ordinal, err := strconv.Atoi(answers.PodOrdinal)
+	if err != nil || ordinal < 0 {
+		return 0, fmt.Errorf("invalid pod ordinal %q", answers.PodOrdinal)
+	}
+	return int32(ordinal), nil
+}
+
+// pluginTypeName returns the name if it is not a builtin enum value, which is provided by a plugin.
+func pluginTypeName(builtin map[string]int32, name string) string {
+	if _, ok := builtin[name]; ok {
+		return ""
+	}
+	return name
+}
+
 func (answers *initAnswer) toApp() *app.App {
-	return &app.App{
-		Name:        answers.Name,
-		ProgramType: app.ProgramType(app.ProgramType_value[answers.Language]),
+	// the pod ordinal is validated before asking the questions, and then chosen from the valid ones.
+	ordinal, _ := answers.podOrdinal()
+	a := &app.App{
+		Name:            answers.Name,
+		ProgramType:     app.ProgramType(app.ProgramType_value[answers.Language]),
+		ProgramTypeName: pluginTypeName(app.ProgramType_value, answers.Language),
 		RemoteRuntime: &app.RemoteRuntime{
-			Namespace:     answers.Namespace,
-			WorkloadType:  app.WorkloadType(app.WorkloadType_value[answers.WorkloadType]),
-			WorkloadName:  answers.Workload,
-			ContainerName: answers.Container,
-			TargetArch:    answers.archType(),
+			Namespace:          answers.Namespace,
+			WorkloadType:       app.WorkloadType(app.WorkloadType_value[answers.WorkloadType]),
+			WorkloadName:       answers.Workload,
+			ContainerName:      answers.Container,
+			TargetArch:         answers.archType(),
+			PodOrdinal:         ordinal,
+			WorkloadApiVersion: answers.WorkloadApiVersion,
+			WorkloadKind:       answers.WorkloadKind,
+		},
+		RemoteConfig: &app.RemoteConfig{
+			DebugMode:         app.DebugMode(app.DebugMode_value[answers.DebugMode]),
+			CloneKeepLabels:   answers.CloneKeepLabels,
+			LaunchMode:        app.LaunchMode(app.LaunchMode_value[answers.LaunchMode]),
+			AttachProcessName: answers.AttachProcess,
 		},
 		LocalConfig: &app.LocalConfig{
 			IdeType:            app.IDEType(app.IDEType_value[answers.IDE]),
+			IdeTypeName:        pluginTypeName(app.IDEType_value, answers.IDE),
 			WorkingDir:         answers.Workdir,
 			AppArgs:            answers.RunArgs,
 			AppEntryPath:       answers.AppEntry,
 			CustomBuildCommand: answers.BuildCommand,
 			BuildOutput:        answers.BuildOutput,
+			StopBehavior:       app.StopBehavior(app.StopBehavior_value[answers.StopBehavior]),
 			DebugToolBuilder: &app.DebugToolBuilder{
 				Type: app.DebugToolType_LOCAL,
 			},
 		},
 	}
+	// saved to the config, so that it can be edited when the derived one is wrong.
+	a.LocalConfig.SourceMappings = langadaptors.DeriveSourceMappings(a)
+	return a
 }
 
 type questionWrap struct {
@@ -127,8 +183,12 @@ type questionWrap struct {
 	bind     *string
 }
 
-func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.Interface, answers *initAnswer) error {
+func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.Interface, kubeConfig *rest.Config, answers *initAnswer) error {
+	if _, err := answers.podOrdinal(); err != nil {
+		return err
+	}
 	workloadPodTemplateMap := map[string]corev1.PodTemplateSpec{}
+	workloadReplicasMap := map[string]int32{}
 	qs := []*questionWrap{
 		{
 			question: func(a *initAnswer) *survey.Question {
@@ -154,7 +214,11 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 					Name: "language",
 					Prompt: &survey.Select{
 						Message: "Choose a programing language:",
-						Options: []string{app.ProgramType_GO.String(), app.ProgramType_RUST.String()},
+						Options: langadaptors.ProgramTypeNames(),
+						Description: func(value string, index int) string {
+							meta, _ := langadaptors.GetMetadata(value)
+							return meta.DebugTool
+						},
 						Default: app.ProgramType_GO.String(),
 					},
 				}
@@ -167,7 +231,15 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 					Name: "remoteArch",
 					Prompt: &survey.Select{
 						Message: "What kind of arch:",
-						Options: []string{app.ArchType_AMD64.String(), app.ArchType_ARM64.String()},
+						Options: func() []string {
+							meta, ok := langadaptors.GetMetadata(a.Language)
+							if !ok {
+								return []string{app.ArchType_AMD64.String(), app.ArchType_ARM64.String()}
+							}
+							return lo.Map(meta.Archs, func(item app.ArchType, index int) string {
+								return item.String()
+							})
+						}(),
 						Default: app.ArchType_AMD64.String(),
 					},
 				}
@@ -199,7 +271,8 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 					Name: "workloadType",
 					Prompt: &survey.Select{
 						Message: "What kind of workload:",
-						Options: []string{app.WorkloadType_DEPLOYMENT.String(), app.WorkloadType_DAEMONSET.String()},
+						Options: []string{app.WorkloadType_DEPLOYMENT.String(), app.WorkloadType_DAEMONSET.String(), app.WorkloadType_STATEFULSET.String(),
+							app.WorkloadType_JOB.String(), app.WorkloadType_CRONJOB.String(), app.WorkloadType_CUSTOM.String()},
 						Default: app.WorkloadType_DEPLOYMENT.String(),
 					},
 				}
@@ -208,6 +281,39 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 		},
 		{
 			question: func(a *initAnswer) *survey.Question {
+				if a.WorkloadType != app.WorkloadType_CUSTOM.String() {
+					return nil
+				}
+				return &survey.Question{
+					Name:     "workloadApiVersion",
+					Prompt:   &survey.Input{Message: "What is the apiVersion of workload(eg. argoproj.io/v1alpha1)?"},
+					Validate: survey.Required,
+				}
+			},
+			bind: &answers.WorkloadApiVersion,
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if a.WorkloadType != app.WorkloadType_CUSTOM.String() {
+					return nil
+				}
+				return &survey.Question{
+					Name:     "workloadKind",
+					Prompt:   &survey.Input{Message: "What is the kind of workload(eg. Rollout)?"},
+					Validate: survey.Required,
+				}
+			},
+			bind: &answers.WorkloadKind,
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if a.WorkloadType == app.WorkloadType_CUSTOM.String() {
+					return &survey.Question{
+						Name:     "workload",
+						Prompt:   &survey.Input{Message: "What is the name of workload?"},
+						Validate: survey.Required,
+					}
+				}
 				return &survey.Question{
 					Name: "workload",
 					Prompt: &survey.Select{
@@ -226,6 +332,32 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 									workloadPodTemplateMap[item.Name] = item.Spec.Template
 									return item.Name
 								})
+							case app.WorkloadType_STATEFULSET.String():
+								stsList, _ := kubeClient.AppsV1().StatefulSets(a.Namespace).List(context.Background(), metav1.ListOptions{})
+								return lo.Map(stsList.Items, func(item appsv1.StatefulSet, index int) string {
+									workloadPodTemplateMap[item.Name] = item.Spec.Template
+									workloadReplicasMap[item.Name] = 1
+									if item.Spec.Replicas != nil {
+										workloadReplicasMap[item.Name] = *item.Spec.Replicas
+									}
+									return item.Name
+								})
+							case app.WorkloadType_JOB.String():
+								jobList, _ := kubeClient.BatchV1().Jobs(a.Namespace).List(context.Background(), metav1.ListOptions{})
+								return lo.FilterMap(jobList.Items, func(item batchv1.Job, index int) (string, bool) {
+									if item.Labels[workloads.DebugLabel] != "" {
+										// skip the debug jobs created by us.
+										return "", false
+									}
+									workloadPodTemplateMap[item.Name] = item.Spec.Template
+									return item.Name, true
+								})
+							case app.WorkloadType_CRONJOB.String():
+								cjList, _ := kubeClient.BatchV1().CronJobs(a.Namespace).List(context.Background(), metav1.ListOptions{})
+								return lo.Map(cjList.Items, func(item batchv1.CronJob, index int) string {
+									workloadPodTemplateMap[item.Name] = item.Spec.JobTemplate.Spec.Template
+									return item.Name
+								})
 							}
 							return nil
 						}(),
@@ -237,6 +369,119 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 		},
 		{
 			question: func(a *initAnswer) *survey.Question {
+				return &survey.Question{
+					Name: "debugMode",
+					Prompt: &survey.Select{
+						Message: "How to debug the workload:",
+						Options: []string{app.DebugMode_PATCH.String(), app.DebugMode_EPHEMERAL.String(), app.DebugMode_CLONE.String()},
+						Description: func(value string, index int) string {
+							switch value {
+							case app.DebugMode_PATCH.String():
+								return "patch the workload command, pods will be restarted"
+							case app.DebugMode_EPHEMERAL.String():
+								return "attach to the running process in an ephemeral container"
+							case app.DebugMode_CLONE.String():
+								return "debug a clone of the workload, the workload keeps serving"
+							}
+							return ""
+						},
+						Default: app.DebugMode_PATCH.String(),
+					},
+				}
+			},
+			bind: &answers.DebugMode,
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if a.DebugMode != app.DebugMode_CLONE.String() {
+					return nil
+				}
+				return &survey.Question{
+					Name: "cloneKeepLabels",
+					Prompt: &survey.Confirm{
+						Message: "Should the clone receive a share of the service traffic?",
+						Default: false,
+					},
+				}
+			},
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if a.DebugMode == app.DebugMode_EPHEMERAL.String() {
+					// the ephemeral debugger always attaches.
+					return nil
+				}
+				return &survey.Question{
+					Name: "launchMode",
+					Prompt: &survey.Select{
+						Message: "How to launch the debugger:",
+						Options: []string{app.LaunchMode_EXEC.String(), app.LaunchMode_ATTACH.String()},
+						Description: func(value string, index int) string {
+							switch value {
+							case app.LaunchMode_EXEC.String():
+								return "relaunch the built binary under the debugger"
+							case app.LaunchMode_ATTACH.String():
+								return "attach to the running process, the in-memory state is kept"
+							}
+							return ""
+						},
+						Default: app.LaunchMode_EXEC.String(),
+					},
+				}
+			},
+			bind: &answers.LaunchMode,
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if a.DebugMode != app.DebugMode_EPHEMERAL.String() && a.LaunchMode != app.LaunchMode_ATTACH.String() {
+					return nil
+				}
+				return &survey.Question{
+					Name: "attachProcess",
+					Prompt: &survey.Input{
+						Message: "Name of the process to attach (empty means the container entrypoint):",
+					},
+				}
+			},
+			bind: &answers.AttachProcess,
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if a.WorkloadType != app.WorkloadType_STATEFULSET.String() {
+					return nil
+				}
+				replicas := workloadReplicasMap[a.Workload]
+				if replicas <= 1 {
+					a.PodOrdinal = "0"
+					return nil
+				}
+				return &survey.Question{
+					Name: "podOrdinal",
+					Prompt: &survey.Select{
+						Message: "Please choose a pod ordinal:",
+						Options: lo.Map(lo.Range(int(replicas)), func(item int, index int) string {
+							return strconv.Itoa(item)
+						}),
+						Default: "0",
+					},
+				}
+			},
+			bind: &answers.PodOrdinal,
+		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				if _, ok := workloadPodTemplateMap[a.Workload]; !ok && a.WorkloadType == app.WorkloadType_CUSTOM.String() {
+					rt := a.toApp().RemoteRuntime
+					accessor, err := workloads.NewAccessor(kubeConfig, rt)
+					if err != nil {
+						log.Fatalf("access workload %s/%s failed: %v", a.Namespace, a.Workload, err)
+					}
+					tmpl, err := accessor.GetTemplate(context.Background(), rt)
+					if err != nil {
+						log.Fatalf("get pod template of workload %s/%s failed: %v", a.Namespace, a.Workload, err)
+					}
+					workloadPodTemplateMap[a.Workload] = *tmpl
+				}
 				if len(workloadPodTemplateMap[a.Workload].Spec.Containers) == 1 {
 					a.Container = workloadPodTemplateMap[a.Workload].Spec.Containers[0].Name
 					return nil
@@ -257,10 +502,25 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 			question: func(a *initAnswer) *survey.Question {
 				if os.Getenv("TERM_PROGRAM") == "vscode" {
 					a.IDE = app.IDEType_VS_CODE.String()
+				} else if os.Getenv("TERM_PROGRAM") == "zed" {
+					a.IDE = app.IDEType_ZED.String()
 				} else if os.Getenv("__CFBundleIdentifier") == "com.jetbrains.goland" {
 					a.IDE = app.IDEType_GOLAND.String()
 				} else if os.Getenv("__CFBundleIdentifier") == "com.jetbrains.CLion" {
 					a.IDE = app.IDEType_CLION.String()
+				} else if os.Getenv("__CFBundleIdentifier") == "com.jetbrains.pycharm" {
+					a.IDE = app.IDEType_PYCHARM.String()
+				} else if os.Getenv("__CFBundleIdentifier") == "com.jetbrains.WebStorm" {
+					a.IDE = app.IDEType_WEBSTORM.String()
+				} else if strings.HasPrefix(os.Getenv("__CFBundleIdentifier"), "com.jetbrains.intellij") {
+					a.IDE = app.IDEType_INTELLIJ.String()
+				} else if os.Getenv("__CFBundleIdentifier") == "com.jetbrains.rider" {
+					a.IDE = app.IDEType_RIDER.String()
+				} else if os.Getenv("NVIM") != "" {
+					// running in the terminal of neovim.
+					a.IDE = app.IDEType_NEOVIM.String()
+				} else if os.Getenv("INSIDE_EMACS") != "" {
+					a.IDE = app.IDEType_EMACS.String()
 				}
 				if a.IDE != "" {
 					fmt.Printf("detected your IDE: %s\n", a.IDE)
@@ -270,15 +530,36 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 					Name: "ide",
 					Prompt: &survey.Select{
 						Message: "Choose a IDE type:",
-						Options: []string{
-							app.IDEType_VS_CODE.String(),
-							app.IDEType_GOLAND.String(),
-							app.IDEType_CLION.String()},
+						Options: ideadapotors.IDETypeNames(),
 					},
 				}
 			},
 			bind: &answers.IDE,
 		},
+		{
+			question: func(a *initAnswer) *survey.Question {
+				return &survey.Question{
+					Name: "stopBehavior",
+					Prompt: &survey.Select{
+						Message: "What to do when the debug session ends:",
+						Options: []string{app.StopBehavior_KEEP.String(), app.StopBehavior_STOP_DEBUGGER.String(), app.StopBehavior_ROLLBACK.String()},
+						Description: func(value string, index int) string {
+							switch value {
+							case app.StopBehavior_KEEP.String():
+								return "keep the debugger running"
+							case app.StopBehavior_STOP_DEBUGGER.String():
+								return "stop the debugger in container"
+							case app.StopBehavior_ROLLBACK.String():
+								return "rollback the workload"
+							}
+							return ""
+						},
+						Default: app.StopBehavior_KEEP.String(),
+					},
+				}
+			},
+			bind: &answers.StopBehavior,
+		},
 		{
 			question: func(a *initAnswer) *survey.Question {
 				return &survey.Question{
@@ -302,34 +583,32 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 		},
 		{
 			question: func(a *initAnswer) *survey.Question {
-				if a.Language == app.ProgramType_GO.String() {
-					paths := []string{""}
-					filepath.Walk(a.Workdir, func(p string, info fs.FileInfo, err error) error {
-						if info.IsDir() {
-							return nil
-						}
-						p = strings.TrimPrefix(p, a.Workdir)
-						if info.Name() == "main.go" {
-							paths = append(paths, "."+path.Dir(p))
+				meta, ok := langadaptors.GetMetadata(a.Language)
+				if !ok || meta.EntryPrompt == "" {
+					return nil
+				}
+				var p survey.Prompt
+				p = &survey.Input{
+					Message: meta.EntryPrompt,
+					Default: func() string {
+						if meta.DefaultEntry == nil {
+							return ""
 						}
-						return nil
-					})
-					var p survey.Prompt
-					p = &survey.Input{
-						Message: "Your app entry path: ",
-					}
-					if len(paths) > 0 {
+						return meta.DefaultEntry(a.toApp())
+					}(),
+				}
+				if meta.EntryOptions != nil {
+					if options := meta.EntryOptions(a.toApp()); len(options) > 0 {
 						p = &survey.Select{
-							Message: "Your app entry path: ",
-							Options: paths,
+							Message: meta.EntryPrompt,
+							Options: options,
 						}
 					}
-					return &survey.Question{
-						Name:   "appEntry",
-						Prompt: p,
-					}
 				}
-				return nil
+				return &survey.Question{
+					Name:   "appEntry",
+					Prompt: p,
+				}
 			},
 			bind: &answers.AppEntry,
 		},
@@ -340,19 +619,11 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 					Prompt: &survey.Input{
 						Message: "How to build your project: ",
 						Default: func() string {
-							switch a.Language {
-							case app.ProgramType_GO.String():
-								// todo support arm64
-								if a.AppEntry != "" {
-									a.BuildOutput = "/tmp/" + a.Name
-									return fmt.Sprintf("GOOS=linux GOARCH=%s go build -o /tmp/%s %s", app.ToSystemArch(a.archType()), a.Name, a.AppEntry)
-								}
-								return fmt.Sprintf("GOOS=linux GOARCH=%s go build -o /tmp/%s ./", app.ToSystemArch(a.archType()), a.Name)
-							case app.ProgramType_RUST.String():
-								return "cargo build"
-							default:
+							meta, ok := langadaptors.GetMetadata(a.Language)
+							if !ok {
 								return ""
 							}
+							return meta.DefaultBuildCommand(a.toApp())
 						}(),
 					},
 				}
@@ -364,19 +635,30 @@ func promptToCreateApp(appClient app.AppManagementClient, kubeClient kubernetes.
 				if a.BuildOutput != "" {
 					return nil
 				}
+				// the default build command, such as go build -o, decides the output.
+				if meta, ok := langadaptors.GetMetadata(a.Language); ok {
+					app_ := a.toApp()
+					if command := meta.DefaultBuildCommand(app_); command != "" && command == a.BuildCommand {
+						if output := meta.DefaultBuildOutput(app_); output != "" {
+							a.BuildOutput = output
+							return nil
+						}
+					}
+				}
 				return &survey.Question{
 					Name: "buildOutput",
 					Prompt: &survey.Input{
-						Message: "Build output binary path: ",
+						Message: "Build output path: ",
 						Default: func() string {
-							switch a.Language {
-							case app.ProgramType_GO.String():
-								return "/tmp/" + a.Name
-							default:
+							meta, ok := langadaptors.GetMetadata(a.Language)
+							if !ok {
 								return ""
 							}
+							return meta.DefaultBuildOutput(a.toApp())
 						}(),
 					},
+					// the output can not be derived from every build command, such as make.
+					Validate: survey.Required,
 				}
 			},
 			bind: &answers.BuildOutput,
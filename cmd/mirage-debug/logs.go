@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+func logsCmd() *cobra.Command {
+	follow := false
+	tail := int64(0)
+	since := time.Duration(0)
+	timestamps := false
+	c := &cobra.Command{
+		Use:   "logs",
+		Short: "Print the output of the program being debugged",
+		Example: `
+	mirage-debug logs a
+	mirage-debug logs a -f --tail 100
+	mirage-debug logs a --since 10m --timestamps
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				log.Fatalf("please specify the project name")
+				return nil
+			}
+			checkOrInitServerCommand()
+			appName := args[0]
+			conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				log.Fatalf("did not connect: %v", err)
+				return nil
+			}
+			defer conn.Close()
+			c := app.NewAppManagementClient(conn)
+			request := &app.LogsRequest{
+				Name:         appName,
+				Follow:       follow,
+				TailLines:    tail,
+				SinceSeconds: int64(since.Seconds()),
+				Timestamps:   timestamps,
+			}
+			stream, err := c.StreamAppLogs(context.Background(), request)
+			if err != nil {
+				log.Fatalf("stream app logs failed: %v", err)
+				return nil
+			}
+			if follow {
+				// the VS Code task waits for this line before launching the debugger.
+				fmt.Fprintf(os.Stderr, "==> streaming logs of %s\n", appName)
+			}
+			podName := ""
+			for {
+				entry, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					log.Fatalf("stream app logs failed: %v", err)
+					return nil
+				}
+				if follow && entry.PodName != podName {
+					fmt.Fprintf(os.Stderr, "==> pod %s\n", entry.PodName)
+					podName = entry.PodName
+				}
+				fmt.Println(entry.Line)
+			}
+		},
+	}
+	c.PersistentFlags().BoolVarP(&follow, "follow", "f", false, "Keep streaming the output, and reattach to the new pod after restarts")
+	c.PersistentFlags().Int64Var(&tail, "tail", 0, "Lines from the end of the output to show, 0 shows all")
+	c.PersistentFlags().DurationVar(&since, "since", 0, "Only show the output newer than a relative duration like 5s, 2m, or 3h")
+	c.PersistentFlags().BoolVar(&timestamps, "timestamps", false, "Include the timestamps of each line")
+
+	return c
+}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"path"
+
 	"github.com/spf13/cobra"
 
 	"github.com/miragedebug/miragedebug/config"
+	"github.com/miragedebug/miragedebug/internal/plugins"
 	"github.com/miragedebug/miragedebug/pkg/log"
 )
 
@@ -23,14 +26,32 @@ func main() {
 	root.PersistentFlags().StringVarP(&httpAddr, "http-addr", "", ":38080", "HTTP listen address.")
 	root.PersistentFlags().StringVarP(&grpcAddr, "grpc-addr", "", ":38081", "GRPC listen address.")
 	root.PersistentFlags().StringVarP(&kubeconfig, "kubeconfig", "k", "~/.kube/config", "Kubeconfig file path.")
-	root.AddCommand(configCmd())
-	root.AddCommand(debugCmd())
-	root.AddCommand(serverCmd())
-	root.AddCommand(initCmd())
+	root.AddCommand(withPlugins(configCmd()))
+	root.AddCommand(withPlugins(debugCmd()))
+	root.AddCommand(withPlugins(attachCmd()))
+	root.AddCommand(stopCmd())
+	root.AddCommand(restartCmd())
+	root.AddCommand(rollbackCmd())
+	root.AddCommand(withPlugins(serverCmd()))
+	root.AddCommand(withPlugins(initCmd()))
 	root.AddCommand(editCmd())
 	root.AddCommand(getCmd())
-	root.AddCommand(deleteCmd())
+	root.AddCommand(statusCmd())
+	root.AddCommand(logsCmd())
+	root.AddCommand(withPlugins(deleteCmd()))
+	// log.Fatalf exits without running the deferred functions.
+	log.RegisterExitHandler(plugins.Shutdown)
+	defer plugins.Shutdown()
 	if err := root.Execute(); err != nil {
 		panic(err)
 	}
 }
+
+// withPlugins loads the plugins before running the command,
+// only the commands resolving the language or IDE adaptors need them.
+func withPlugins(c *cobra.Command) *cobra.Command {
+	c.PreRun = func(cmd *cobra.Command, args []string) {
+		plugins.Load(path.Join(config.GetConfigRootPath(), "plugins"))
+	}
+	return c
+}
@@ -6,12 +6,14 @@ import (
 	"github.com/miragedebug/miragedebug/api/app"
 	"github.com/miragedebug/miragedebug/config"
 	"github.com/miragedebug/miragedebug/internal/apps"
+	"github.com/miragedebug/miragedebug/internal/plugins"
 	"github.com/miragedebug/miragedebug/internal/servers"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"os"
 	"os/exec"
+	"os/signal"
 	"strings"
 	"syscall"
 	"time"
@@ -62,7 +64,8 @@ func serverStopCmd() *cobra.Command {
 				fmt.Printf("server maybe stopped\n")
 				return nil
 			}
-			syscall.Kill(int(si.Pid), 9)
+			// SIGTERM, so that the server kills its plugins before exiting.
+			syscall.Kill(int(si.Pid), syscall.SIGTERM)
 			return nil
 		},
 	}
@@ -78,6 +81,14 @@ func serverCmd() *cobra.Command {
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config.SetKubeconfig(kubeconfig)
+			// kill the plugins when the server is stopped.
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				plugins.Shutdown()
+				os.Exit(0)
+			}()
 			grpcServer := servers.NewGRPCServer(grpcAddr, apps.RegisterGRPCRoutes)
 			go grpcServer.Run()
 			gwServer := servers.NewGatewayServer("mirage debug server", httpAddr, grpcAddr, apps.RegisterHTTPRoutes())
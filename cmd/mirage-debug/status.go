@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+const statusTableWriter = "%-20s%-12s%-12s%-12s%-50s%s\n"
+
+func statusCmd() *cobra.Command {
+	watch := false
+	c := &cobra.Command{
+		Use:   "status",
+		Short: "Show the debugging status of a project",
+		Example: `
+	mirage-debug status a
+	mirage-debug status a -w
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				log.Fatalf("please specify the project name")
+				return nil
+			}
+			checkOrInitServerCommand()
+			appName := args[0]
+			conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				log.Fatalf("did not connect: %v", err)
+				return nil
+			}
+			defer conn.Close()
+			c := app.NewAppManagementClient(conn)
+			fmt.Printf(statusTableWriter, "NAME", "CONFIGURED", "CONNECTED", "DEBUGGING", "ERROR", "EVENT")
+			if !watch {
+				s, err := c.GetAppStatus(context.Background(), &app.SingleAppRequest{
+					Name: appName,
+				})
+				if err != nil {
+					log.Fatalf("get app status failed: %v", err)
+					return nil
+				}
+				printStatus(s)
+				return nil
+			}
+			stream, err := c.WatchAppStatus(context.Background(), &app.SingleAppRequest{
+				Name: appName,
+			})
+			if err != nil {
+				log.Fatalf("watch app status failed: %v", err)
+				return nil
+			}
+			for {
+				s, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					log.Fatalf("watch app status failed: %v", err)
+					return nil
+				}
+				printStatus(s)
+			}
+		},
+	}
+	c.PersistentFlags().BoolVarP(&watch, "watch", "w", false, "Watch the status changes")
+
+	return c
+}
+
+func printStatus(s *app.Status) {
+	fmt.Printf(statusTableWriter, s.AppName, fmt.Sprint(s.Configured), fmt.Sprint(s.Connected), fmt.Sprint(s.Debugging), s.Error, s.Event)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+func stopCmd() *cobra.Command {
+	gracePeriod := time.Duration(0)
+	c := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the debugger in container",
+		Example: `
+	mirage-debug stop a
+	mirage-debug stop a --grace-period 30s
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				log.Fatalf("please specify the project name")
+				return nil
+			}
+			checkOrInitServerCommand()
+			appName := args[0]
+			conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				log.Fatalf("did not connect: %v", err)
+				return nil
+			}
+			defer conn.Close()
+			c := app.NewAppManagementClient(conn)
+			_, err = c.StopDebugging(context.Background(), &app.StopDebuggingRequest{
+				Name:               appName,
+				GracePeriodSeconds: int32(gracePeriod.Seconds()),
+			})
+			if err != nil {
+				log.Fatalf("Stop debugging failed: %v", err)
+				return nil
+			}
+			fmt.Println("Stop debugging success")
+			return nil
+		},
+	}
+	c.PersistentFlags().DurationVar(&gracePeriod, "grace-period", 0, "Time to wait after SIGTERM before SIGKILL, 0 uses the server default (10s)")
+
+	return c
+}
+
+func restartCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restart",
+		Short: "Restart the debugger in container without rebuilding",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				log.Fatalf("please specify the project name")
+				return nil
+			}
+			checkOrInitServerCommand()
+			appName := args[0]
+			conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				log.Fatalf("did not connect: %v", err)
+				return nil
+			}
+			defer conn.Close()
+			c := app.NewAppManagementClient(conn)
+			_, err = c.RestartDebugging(context.Background(), &app.SingleAppRequest{
+				Name: appName,
+			})
+			if err != nil {
+				log.Fatalf("Restart debugging failed: %v", err)
+				return nil
+			}
+			fmt.Println("Restart debugging success")
+			return nil
+		},
+	}
+
+	return c
+}
+
+func rollbackCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the workload to the initial config",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				log.Fatalf("please specify the project name")
+				return nil
+			}
+			checkOrInitServerCommand()
+			appName := args[0]
+			conn, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				log.Fatalf("did not connect: %v", err)
+				return nil
+			}
+			defer conn.Close()
+			c := app.NewAppManagementClient(conn)
+			_, err = c.RollbackApp(context.Background(), &app.SingleAppRequest{
+				Name: appName,
+			})
+			if err != nil {
+				log.Fatalf("Rollback app failed: %v", err)
+				return nil
+			}
+			fmt.Println("Rollback app success")
+			return nil
+		},
+	}
+
+	return c
+}
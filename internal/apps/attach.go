@@ -0,0 +1,43 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/internal/kube"
+)
+
+// the entrypoint of the container, in EPHEMERAL mode the process namespace
+// is shared with the ephemeral container by targetContainerName.
+const entrypointPID = 1
+
+func isAttachMode(app_ *app.App) bool {
+	return isEphemeralMode(app_) || app_.GetRemoteConfig().GetLaunchMode() == app.LaunchMode_ATTACH
+}
+
+// findTargetPID returns the PID of the process to attach in the container,
+// the oldest process matching AttachProcessName, or the entrypoint of the container.
+func (a *appManagement) findTargetPID(ctx context.Context, app_ *app.App, podName, container string) (int, error) {
+	name := app_.RemoteConfig.GetAttachProcessName()
+	if name == "" {
+		return entrypointPID, nil
+	}
+	// pgrep is missing in some minimal images, pidof is the fallback.
+	stdout, stderr, err := kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, podName, container,
+		fmt.Sprintf("pgrep -o -x %s || pidof -s %s", name, name), nil)
+	if err != nil {
+		return 0, fmt.Errorf("find process %s in pod %s failed: %v, %s", name, podName, err, string(stderr))
+	}
+	fields := strings.Fields(string(stdout))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("no process %s found in pod %s", name, podName)
+	}
+	pid, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid pid of process %s: %v", name, err)
+	}
+	return pid, nil
+}
@@ -0,0 +1,95 @@
+package apps
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/pointer"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+const cloneSuffix = "-mirage"
+
+func isCloneMode(app_ *app.App) bool {
+	return app_.GetRemoteConfig().GetDebugMode() == app.DebugMode_CLONE
+}
+
+func cloneName(app_ *app.App) string {
+	return app_.RemoteRuntime.WorkloadName + cloneSuffix
+}
+
+func cloneSelector(app_ *app.App) map[string]string {
+	return map[string]string{configDebugLabel: app_.Name}
+}
+
+// applyClone creates or updates the clone deployment of the workload with the debug template.
+func (a *appManagement) applyClone(ctx context.Context, app_ *app.App, tmpl corev1.PodTemplateSpec) error {
+	tmpl = *tmpl.DeepCopy()
+	if !app_.RemoteConfig.GetCloneKeepLabels() {
+		// drop the labels of the workload, so the clone is not selected by the services.
+		tmpl.Labels = cloneSelector(app_)
+	}
+	// the template may come from jobs.
+	tmpl.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	client := a.kubeclient.AppsV1().Deployments(app_.RemoteRuntime.Namespace)
+	dep, err := client.Get(ctx, cloneName(app_), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		dep = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cloneName(app_),
+				Namespace: app_.RemoteRuntime.Namespace,
+				Labels:    cloneSelector(app_),
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: pointer.Int32(1),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: cloneSelector(app_),
+				},
+				Template: tmpl,
+				// never run two debug pods at the same time.
+				Strategy: appsv1.DeploymentStrategy{
+					Type: appsv1.RecreateDeploymentStrategyType,
+				},
+			},
+		}
+		_, err = client.Create(ctx, dep, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	dep.Spec.Template = tmpl
+	_, err = client.Update(ctx, dep, metav1.UpdateOptions{})
+	return err
+}
+
+func (a *appManagement) cloneExists(ctx context.Context, app_ *app.App) (bool, error) {
+	_, err := a.kubeclient.AppsV1().Deployments(app_.RemoteRuntime.Namespace).Get(ctx, cloneName(app_), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (a *appManagement) deleteClone(ctx context.Context, app_ *app.App) error {
+	err := a.kubeclient.AppsV1().Deployments(app_.RemoteRuntime.Namespace).Delete(ctx, cloneName(app_), metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (a *appManagement) listClonePods(ctx context.Context, app_ *app.App) ([]corev1.Pod, error) {
+	podList, err := a.kubeclient.CoreV1().Pods(app_.RemoteRuntime.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(cloneSelector(app_)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
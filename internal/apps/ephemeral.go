@@ -0,0 +1,105 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+const (
+	ephemeralContainerPrefix = "mirage-debugger"
+	defaultEphemeralImage    = "busybox"
+)
+
+func isEphemeralMode(app_ *app.App) bool {
+	return app_.GetRemoteConfig().GetDebugMode() == app.DebugMode_EPHEMERAL
+}
+
+// runningEphemeralDebugger returns the name of the running ephemeral debugger container
+// which targets the app container, or empty if not found.
+func runningEphemeralDebugger(app_ *app.App, pod *corev1.Pod) string {
+	for _, c := range pod.Spec.EphemeralContainers {
+		if !strings.HasPrefix(c.Name, ephemeralContainerPrefix) || c.TargetContainerName != app_.RemoteRuntime.ContainerName {
+			continue
+		}
+		for _, s := range pod.Status.EphemeralContainerStatuses {
+			if s.Name == c.Name && s.State.Running != nil {
+				return c.Name
+			}
+		}
+	}
+	return ""
+}
+
+// debuggerContainer returns the container to run the debug tool in.
+func debuggerContainer(app_ *app.App, pod *corev1.Pod) (string, error) {
+	if !isEphemeralMode(app_) {
+		return app_.RemoteRuntime.ContainerName, nil
+	}
+	c := runningEphemeralDebugger(app_, pod)
+	if c == "" {
+		return "", fmt.Errorf("no running ephemeral debugger container found in pod %s", pod.Name)
+	}
+	return c, nil
+}
+
+// ensureEphemeralDebugger adds an ephemeral debugger container which shares the process namespace
+// with the app container, the running ephemeral debugger will be reused.
+// Ephemeral containers can not be removed or restarted, so a terminated one is replaced by a new one.
+func (a *appManagement) ensureEphemeralDebugger(ctx context.Context, app_ *app.App, pod *corev1.Pod) (string, error) {
+	if c := runningEphemeralDebugger(app_, pod); c != "" {
+		return c, nil
+	}
+	image := app_.RemoteConfig.EphemeralImage
+	if image == "" {
+		image = defaultEphemeralImage
+	}
+	name := fmt.Sprintf("%s-%d", ephemeralContainerPrefix, len(pod.Spec.EphemeralContainers))
+	pod = pod.DeepCopy()
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:    name,
+			Image:   image,
+			Command: []string{"/bin/sh"},
+			Args:    []string{"-c", "tail -f /dev/null"},
+			SecurityContext: &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{
+					// attaching to the process requires ptrace.
+					Add: []corev1.Capability{"SYS_PTRACE"},
+				},
+			},
+		},
+		TargetContainerName: app_.RemoteRuntime.ContainerName,
+	})
+	podClient := a.kubeclient.CoreV1().Pods(pod.Namespace)
+	if _, err := podClient.UpdateEphemeralContainers(ctx, pod.Name, pod, metav1.UpdateOptions{}); err != nil {
+		return "", fmt.Errorf("add ephemeral container to pod %s failed: %v", pod.Name, err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*60)
+	defer cancel()
+	ticker := time.NewTicker(time.Second * 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p, err := podClient.Get(ctx, pod.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Errorf("get pod %s failed: %v", pod.Name, err)
+				continue
+			}
+			if runningEphemeralDebugger(app_, p) == name {
+				return name, nil
+			}
+			log.Debugf("ephemeral container %s of pod %s is not running", name, pod.Name)
+		case <-ctx.Done():
+			return "", fmt.Errorf("wait for ephemeral container %s of pod %s running timeout", name, pod.Name)
+		}
+	}
+}
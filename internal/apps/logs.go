@@ -0,0 +1,105 @@
+package apps
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+const logsReattachInterval = time.Second * 3
+
+// StreamAppLogs streams the logs of the app container. The program launched by the debugger
+// writes to /tmp/mirage-debug-output, which is tailed by the container in the patch modes,
+// and the attached process writes to the container directly, so the container logs cover both.
+func (a *appManagement) StreamAppLogs(request *app.LogsRequest, stream app.AppManagement_StreamAppLogsServer) error {
+	ctx := stream.Context()
+	app_, ok := a.getApp(request.Name)
+	if !ok {
+		return fmt.Errorf("app %s not found", request.Name)
+	}
+	var lastPod string
+	var lastTime time.Time
+	for {
+		pod, err := a.getAppRelatedPod(ctx, app_)
+		if err == nil {
+			opts := &corev1.PodLogOptions{
+				Container: app_.GetRemoteRuntime().GetContainerName(),
+				Follow:    request.Follow,
+				// the timestamps are always requested to resume after the container restarts.
+				Timestamps: true,
+			}
+			switch {
+			case lastPod == "":
+				if request.TailLines > 0 {
+					opts.TailLines = &request.TailLines
+				}
+				if request.SinceSeconds > 0 {
+					opts.SinceSeconds = &request.SinceSeconds
+				}
+			case lastPod == pod.Name && !lastTime.IsZero():
+				// the container is restarted, only the new lines are wanted.
+				since := metav1.NewTime(lastTime)
+				opts.SinceTime = &since
+			}
+			if lastPod != pod.Name {
+				lastTime = time.Time{}
+			}
+			lastPod = pod.Name
+			lastTime, err = a.streamPodLogs(ctx, stream, app_, pod.Name, opts, request.Timestamps, lastTime)
+		}
+		if !request.Follow {
+			return err
+		}
+		if err != nil {
+			log.Debugf("stream logs of app %s failed: %v", app_.Name, err)
+		}
+		// the stream ends when the container or the pod is gone, reattach to the new one.
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(logsReattachInterval):
+		}
+	}
+}
+
+// streamPodLogs sends the lines newer than since to the stream, and returns the time of the last line.
+func (a *appManagement) streamPodLogs(ctx context.Context, stream app.AppManagement_StreamAppLogsServer, app_ *app.App,
+	podName string, opts *corev1.PodLogOptions, timestamps bool, since time.Time) (time.Time, error) {
+	reader, err := a.kubeclient.CoreV1().Pods(app_.RemoteRuntime.Namespace).GetLogs(podName, opts).Stream(ctx)
+	if err != nil {
+		return since, err
+	}
+	defer reader.Close()
+	last := since
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		ts, content, _ := strings.Cut(line, " ")
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			// SinceTime is in seconds, the lines already sent are skipped.
+			if !since.IsZero() && !t.After(since) {
+				continue
+			}
+			last = t
+		}
+		if !timestamps {
+			line = content
+		}
+		if err := stream.Send(&app.LogEntry{
+			PodName: podName,
+			Line:    line,
+		}); err != nil {
+			return last, err
+		}
+	}
+	return last, scanner.Err()
+}
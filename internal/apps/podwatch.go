@@ -0,0 +1,157 @@
+package apps
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/internal/kube"
+	debug_tools "github.com/miragedebug/miragedebug/internal/local/debug-tools"
+	"github.com/miragedebug/miragedebug/internal/workloads"
+)
+
+const podReplacedTimeout = time.Minute * 3
+
+// updateDebugConfig updates the debug config of the app in place, it is created if missing.
+func (a *appManagement) updateDebugConfig(name string, update func(c *appDebugConfig)) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	c := a.debugConfigMap[name]
+	update(&c)
+	a.debugConfigMap[name] = c
+}
+
+// releaseDebugConfig stops the port-forward and the pod watcher of the app.
+func (a *appManagement) releaseDebugConfig(name string) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	c, ok := a.debugConfigMap[name]
+	if !ok {
+		return
+	}
+	if c.podPortForwarder != nil {
+		c.podPortForwarder.Stop()
+	}
+	if c.podWatcher != nil {
+		c.podWatcher.Stop()
+	}
+	delete(a.debugConfigMap, name)
+}
+
+// forwardPort forwards the debugging port to the pod, the port-forward to another pod or port is replaced.
+func (a *appManagement) forwardPort(app_ *app.App, podName string) error {
+	port := app_.RemoteConfig.RemoteDebuggingPort
+	var err error
+	a.updateDebugConfig(app_.Name, func(c *appDebugConfig) {
+		if c.podPortForwarder != nil {
+			if c.port == port && c.podPortForwarder.PodName() == podName {
+				return
+			}
+			c.podPortForwarder.Stop()
+		}
+		pf := kube.NewPodPortForwarder(a.kubeconfig, app_.RemoteRuntime.Namespace, podName, port, port)
+		if err = pf.Start(); err != nil {
+			c.podPortForwarder = nil
+			return
+		}
+		c.port = port
+		c.podPortForwarder = pf
+	})
+	return err
+}
+
+// podSelector returns the label selector of the pods may be debugged,
+// the pods of the workload are labeled by the template if the workload is modified.
+func (a *appManagement) podSelector(ctx context.Context, app_ *app.App) (string, error) {
+	if isCloneMode(app_) {
+		return labels.SelectorFromSet(cloneSelector(app_)).String(), nil
+	}
+	tmpl, err := a.getAppRelatedWorkloadTemplate(ctx, app_)
+	if err != nil {
+		return "", err
+	}
+	return labels.SelectorFromSet(tmpl.Labels).String(), nil
+}
+
+// targetPod picks the pod to debug like getAppRelatedPod, but only the running ones.
+func targetPod(app_ *app.App) func(pods []corev1.Pod) *corev1.Pod {
+	return func(pods []corev1.Pod) *corev1.Pod {
+		var running []corev1.Pod
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+				continue
+			}
+			if app_.RemoteRuntime.WorkloadType == app.WorkloadType_STATEFULSET && !isCloneMode(app_) &&
+				pod.Name != workloads.StatefulSetPodName(app_.RemoteRuntime) {
+				continue
+			}
+			running = append(running, pod)
+		}
+		pod, err := newestPod(running)
+		if err != nil {
+			return nil
+		}
+		return pod
+	}
+}
+
+// watchPods watches the pods of the app, the debugging follows the pod when it is replaced.
+func (a *appManagement) watchPods(ctx context.Context, app_ *app.App, pod *corev1.Pod) error {
+	selector, err := a.podSelector(ctx, app_)
+	if err != nil {
+		return err
+	}
+	name := app_.Name
+	a.updateDebugConfig(name, func(c *appDebugConfig) {
+		if c.podWatcher != nil {
+			c.podWatcher.Stop()
+		}
+		c.podWatcher = kube.NewPodWatcher(a.kubeclient, app_.RemoteRuntime.Namespace, selector, pod, targetPod(app_))
+		c.podWatcher.Start(func(pod *corev1.Pod) {
+			a.onPodReplaced(name, pod)
+		})
+	})
+	return nil
+}
+
+// onPodReplaced installs the debug tool into the new pod, rebinds the port-forward,
+// and restarts debugging if the app was debugging.
+func (a *appManagement) onPodReplaced(name string, pod *corev1.Pod) {
+	app_, ok := a.getApp(name)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), podReplacedTimeout)
+	defer cancel()
+	a.emitEvent(name, "pod replaced by %s, installing the debug tool", pod.Name)
+	container := app_.RemoteRuntime.ContainerName
+	if isEphemeralMode(app_) {
+		var err error
+		container, err = a.ensureEphemeralDebugger(ctx, app_, pod)
+		if err != nil {
+			a.emitEvent(name, "add ephemeral debugger to pod %s failed: %v", pod.Name, err)
+			return
+		}
+	}
+	if err := debug_tools.InstallPodDebugTool(ctx, app_, a.kubeconfig, pod.Name, container); err != nil {
+		a.emitEvent(name, "install debug tool into pod %s failed: %v", pod.Name, err)
+		return
+	}
+	a.save(app_)
+	if err := a.forwardPort(app_, pod.Name); err != nil {
+		a.emitEvent(name, "forward port to pod %s failed: %v", pod.Name, err)
+		return
+	}
+	a.emitEvent(name, "port-forward rebound to pod %s", pod.Name)
+	if c, ok := a.getDebugConfig(name); !ok || !c.debugging {
+		return
+	}
+	if _, err := a.StartDebugging(ctx, &app.SingleAppRequest{Name: name}); err != nil {
+		a.emitEvent(name, "restart debugging in pod %s failed: %v", pod.Name, err)
+		return
+	}
+	a.emitEvent(name, "debugging restarted in pod %s", pod.Name)
+}
@@ -0,0 +1,180 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/internal/kube"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+const (
+	debugOutputFile = "/tmp/mirage-debug-output"
+	// debugPIDFile records the process group of the debug tool started by MirageDebug.
+	debugPIDFile = "/tmp/mirage-debug.pid"
+
+	defaultStopGracePeriod = time.Second * 10
+	defaultReadyTimeout    = time.Second * 30
+	readyProbeInterval     = time.Millisecond * 500
+	// maxStartupOutput is the max bytes of the startup output reported when the debugger is not ready.
+	maxStartupOutput = 4096
+)
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// launchCommand runs the debug command in a new session, so the debug tool and the app
+// launched by it are in a process group of their own, which is recorded in debugPIDFile.
+// The attached process is not in the group.
+func launchCommand(command string) string {
+	script := fmt.Sprintf("echo $$ >%s; exec %s", debugPIDFile, command)
+	return fmt.Sprintf("setsid sh -c %s >>%s 2>&1", shellQuote(script), debugOutputFile)
+}
+
+// stopCommand sends SIGTERM to the process group in debugPIDFile, then SIGKILL
+// if it is still alive after the grace period.
+func stopCommand(gracePeriod time.Duration) string {
+	return fmt.Sprintf(`pgid=$(cat %[1]s 2>/dev/null); [ -n "$pgid" ] || exit 0
+if kill -TERM -$pgid 2>/dev/null; then
+  i=0
+  while [ $i -lt %[2]d ] && kill -0 -$pgid 2>/dev/null; do sleep 1; i=$((i+1)); done
+  kill -KILL -$pgid 2>/dev/null
+fi
+rm -f %[1]s`, debugPIDFile, int(gracePeriod.Seconds()))
+}
+
+// debugOutputSize returns the size of debugOutputFile, the output of a new debugger is after it.
+func (a *appManagement) debugOutputSize(ctx context.Context, app_ *app.App, podName, container string) int64 {
+	stdout, _, err := kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, podName, container,
+		fmt.Sprintf("wc -c <%s 2>/dev/null || echo 0", debugOutputFile), nil)
+	if err != nil {
+		return 0
+	}
+	size, _ := strconv.ParseInt(strings.TrimSpace(string(stdout)), 10, 64)
+	return size
+}
+
+// debugOutputSince returns the tail of debugOutputFile after the offset.
+func (a *appManagement) debugOutputSince(ctx context.Context, app_ *app.App, podName, container string, offset int64) string {
+	stdout, stderr, err := kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, podName, container,
+		fmt.Sprintf("tail -c +%d %s | tail -c %d", offset+1, debugOutputFile, maxStartupOutput), nil)
+	if err != nil {
+		return fmt.Sprintf("<read %s failed: %v, %s>", debugOutputFile, err, strings.TrimSpace(string(stderr)))
+	}
+	return string(stdout)
+}
+
+// isOneShotAttach returns whether the debugger is activated by a command exiting once it is done.
+func isOneShotAttach(app_ *app.App) bool {
+	m, _ := langadaptors.GetMetadata(app.ProgramTypeName(app_))
+	return isAttachMode(app_) && m.OneShotAttach
+}
+
+// waitDebuggerReady probes the debugger through the forwarded port or in the pod until it is ready,
+// it fails if the debugger exits or is not ready in time.
+func (a *appManagement) waitDebuggerReady(ctx context.Context, app_ *app.App, langAdaptor langadaptors.LanguageAdaptor,
+	podName, container string, exited <-chan error) error {
+	timeout := defaultReadyTimeout
+	if s := app_.RemoteConfig.GetReadyTimeoutSeconds(); s > 0 {
+		timeout = time.Duration(s) * time.Second
+	}
+	return waitReady(ctx, timeout, isOneShotAttach(app_), func() error {
+		return a.probeDebugger(ctx, app_, langAdaptor, podName, container)
+	}, exited)
+}
+
+// probeDebugger runs the readiness probe of the language adaptor through the forwarded port or in the pod.
+func (a *appManagement) probeDebugger(ctx context.Context, app_ *app.App, langAdaptor langadaptors.LanguageAdaptor,
+	podName, container string) error {
+	addr := fmt.Sprintf("127.0.0.1:%d", app_.RemoteConfig.RemoteDebuggingPort)
+	executor := func(commands []string) ([]byte, []byte, error) {
+		return kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, podName, container, strings.Join(commands, " && "), nil)
+	}
+	return langAdaptor.ReadinessProbe(app_, addr, executor)
+}
+
+// waitReady calls probe until it succeeds. The exit of the launcher fails it,
+// except the clean exit of a one-shot launcher, which only activates the debugger.
+func waitReady(ctx context.Context, timeout time.Duration, oneShot bool, probe func() error, exited <-chan error) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(readyProbeInterval)
+	defer ticker.Stop()
+	probeErr := fmt.Errorf("not probed")
+	for {
+		select {
+		case err := <-exited:
+			if err != nil {
+				return fmt.Errorf("debugger exited: %v", err)
+			}
+			if !oneShot {
+				return fmt.Errorf("debugger exited")
+			}
+			// keep probing until the deadline.
+			exited = nil
+		case <-deadline:
+			return fmt.Errorf("timeout after %s: %v", timeout, probeErr)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if probeErr = probe(); probeErr == nil {
+				return nil
+			}
+		}
+	}
+}
+
+// killDebugging stops the debug tool started by MirageDebug, and the app launched by it.
+// The attached process is kept running.
+func (a *appManagement) killDebugging(ctx context.Context, app_ *app.App, podName, container string, gracePeriod time.Duration) error {
+	_, stderr, err := kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, podName, container, stopCommand(gracePeriod), nil)
+	if err != nil {
+		return fmt.Errorf("stop debugging in pod %s failed: %v, %s", podName, err, strings.TrimSpace(string(stderr)))
+	}
+	return nil
+}
+
+func (a *appManagement) StopDebugging(ctx context.Context, request *app.StopDebuggingRequest) (*app.Empty, error) {
+	app_, ok := a.getApp(request.Name)
+	if !ok {
+		return nil, fmt.Errorf("app %s not found", request.Name)
+	}
+	gracePeriod := defaultStopGracePeriod
+	if request.GracePeriodSeconds > 0 {
+		gracePeriod = time.Duration(request.GracePeriodSeconds) * time.Second
+	}
+	if err := a.stopDebugging(ctx, app_, gracePeriod); err != nil {
+		return nil, err
+	}
+	return &app.Empty{}, nil
+}
+
+// stopDebugging stops the debugger in the pod of the app.
+func (a *appManagement) stopDebugging(ctx context.Context, app_ *app.App, gracePeriod time.Duration) error {
+	pod, err := a.getAppRelatedPod(ctx, app_)
+	if err != nil {
+		return err
+	}
+	container, err := debuggerContainer(app_, pod)
+	if err != nil {
+		return err
+	}
+	if err := a.killDebugging(ctx, app_, pod.Name, container, gracePeriod); err != nil {
+		return err
+	}
+	a.updateDebugConfig(app_.Name, func(c *appDebugConfig) {
+		c.debugging = false
+	})
+	log.Infof("debugging of app %s in pod %s stopped", app_.Name, pod.Name)
+	return nil
+}
+
+func (a *appManagement) RestartDebugging(ctx context.Context, request *app.SingleAppRequest) (*app.Empty, error) {
+	// StartDebugging stops the running debugging before starting.
+	return a.StartDebugging(ctx, request)
+}
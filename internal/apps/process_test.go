@@ -0,0 +1,88 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+func TestWaitReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		oneShot    bool
+		exitErr    error
+		readyAfter int
+		wantErr    bool
+	}{
+		{name: "one-shot launcher exits cleanly", oneShot: true, readyAfter: 2},
+		{name: "one-shot launcher fails", oneShot: true, exitErr: errors.New("no such process"), readyAfter: 2, wantErr: true},
+		{name: "debugger exits cleanly", readyAfter: 2, wantErr: true},
+		{name: "one-shot launcher never ready", oneShot: true, readyAfter: 100, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exited := make(chan error, 1)
+			// the launcher exits before the first probe.
+			exited <- tt.exitErr
+			probes := 0
+			err := waitReady(context.Background(), readyProbeInterval*4, tt.oneShot, func() error {
+				probes++
+				if probes < tt.readyAfter {
+					return errors.New("connection refused")
+				}
+				return nil
+			}, exited)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("waitReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitReadyBeforeExit(t *testing.T) {
+	exited := make(chan error)
+	start := time.Now()
+	if err := waitReady(context.Background(), time.Second*5, false, func() error { return nil }, exited); err != nil {
+		t.Fatalf("waitReady() error = %v", err)
+	}
+	if time.Since(start) > readyProbeInterval*2 {
+		t.Errorf("waitReady() took %s", time.Since(start))
+	}
+}
+
+func TestWaitReadyOneShotAttach(t *testing.T) {
+	tests := []struct {
+		name        string
+		programType app.ProgramType
+		remote      *app.RemoteConfig
+		wantErr     bool
+	}{
+		{name: "python attach", programType: app.ProgramType_PYTHON, remote: &app.RemoteConfig{LaunchMode: app.LaunchMode_ATTACH}},
+		{name: "python ephemeral", programType: app.ProgramType_PYTHON, remote: &app.RemoteConfig{DebugMode: app.DebugMode_EPHEMERAL}},
+		{name: "node attach", programType: app.ProgramType_NODE, remote: &app.RemoteConfig{LaunchMode: app.LaunchMode_ATTACH}},
+		{name: "python exec", programType: app.ProgramType_PYTHON, remote: &app.RemoteConfig{}, wantErr: true},
+		{name: "go attach", programType: app.ProgramType_GO, remote: &app.RemoteConfig{LaunchMode: app.LaunchMode_ATTACH}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &app.App{ProgramType: tt.programType, RemoteConfig: tt.remote}
+			exited := make(chan error, 1)
+			// the launcher exits cleanly before the debugger accepts connections.
+			exited <- nil
+			probes := 0
+			err := waitReady(context.Background(), readyProbeInterval*4, isOneShotAttach(a), func() error {
+				probes++
+				if probes < 2 {
+					return errors.New("connection refused")
+				}
+				return nil
+			}, exited)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("waitReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
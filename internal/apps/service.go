@@ -12,9 +12,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -26,13 +25,13 @@ import (
 	"github.com/miragedebug/miragedebug/config"
 	"github.com/miragedebug/miragedebug/internal/kube"
 	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
-	"github.com/miragedebug/miragedebug/internal/lang-adaptors/golang"
-	"github.com/miragedebug/miragedebug/internal/lang-adaptors/rust"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/all"
 	debug_tools "github.com/miragedebug/miragedebug/internal/local/debug-tools"
+	"github.com/miragedebug/miragedebug/internal/workloads"
 	"github.com/miragedebug/miragedebug/pkg/log"
 )
 
-const configDebugLabel = "miragedebug.io/debug"
+const configDebugLabel = workloads.DebugLabel
 const appFileSuffix = ".yaml"
 
 func appsDir() string {
@@ -64,6 +63,9 @@ func readApp(name string) (*app.App, error) {
 type appDebugConfig struct {
 	port             int32
 	podPortForwarder *kube.PodPortForwarder
+	podWatcher       *kube.PodWatcher
+	// debugging indicates whether debugging is started, it is restarted in the new pod.
+	debugging bool
 }
 
 type appManagement struct {
@@ -73,6 +75,7 @@ type appManagement struct {
 	kubeconfig     *rest.Config
 	kubeclient     kubernetes.Interface
 	debugConfigMap map[string]appDebugConfig
+	events         map[string]*appEvent
 }
 
 func (a *appManagement) init() {
@@ -84,6 +87,7 @@ func (a *appManagement) init() {
 	os.MkdirAll(appsDir(), 0755)
 	a.inited = true
 	a.debugConfigMap = make(map[string]appDebugConfig)
+	a.events = make(map[string]*appEvent)
 	cfg, err := clientcmd.BuildConfigFromFlags("", config.GetKubeconfig())
 	if err != nil {
 		panic(err)
@@ -101,67 +105,59 @@ func (a *appManagement) save(app_ *app.App) error {
 }
 
 func (a *appManagement) getAppRelatedWorkloadTemplate(ctx context.Context, app_ *app.App) (*corev1.PodTemplateSpec, error) {
-	switch app_.RemoteRuntime.WorkloadType {
-	case app.WorkloadType_DEPLOYMENT:
-		dep, err := a.kubeclient.AppsV1().Deployments(app_.RemoteRuntime.Namespace).Get(ctx, app_.RemoteRuntime.WorkloadName, metav1.GetOptions{})
-		if err != nil {
-			return nil, err
-		}
-		return &dep.Spec.Template, nil
-	case app.WorkloadType_DAEMONSET:
-		dep, err := a.kubeclient.AppsV1().DaemonSets(app_.RemoteRuntime.Namespace).Get(ctx, app_.RemoteRuntime.WorkloadName, metav1.GetOptions{})
-		if err != nil {
-			return nil, err
-		}
-		return &dep.Spec.Template, nil
+	accessor, err := workloads.NewAccessor(a.kubeconfig, app_.RemoteRuntime)
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("unsupported workload type %s", app_.RemoteRuntime.WorkloadType.String())
+	return accessor.GetTemplate(ctx, app_.RemoteRuntime)
 }
 
 func (a *appManagement) getAppRelatedPod(ctx context.Context, app_ *app.App) (*corev1.Pod, error) {
-	ls := fmt.Sprintf("%s=%s", configDebugLabel, app_.Name)
-	if app_.RemoteConfig.GetNoModifyConfig() {
-		tmpl, err := a.getAppRelatedWorkloadTemplate(ctx, app_)
+	if isCloneMode(app_) {
+		pods, err := a.listClonePods(ctx, app_)
 		if err != nil {
 			return nil, err
 		}
-		ls = labels.SelectorFromSet(tmpl.Labels).String()
+		return newestPod(pods)
 	}
-	podList, err := a.kubeclient.CoreV1().Pods(app_.RemoteRuntime.Namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: ls,
-	})
+	accessor, err := workloads.NewAccessor(a.kubeconfig, app_.RemoteRuntime)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := accessor.ListPods(ctx, app_.RemoteRuntime)
 	if err != nil {
 		return nil, err
 	}
-	sort.Slice(podList.Items, func(i, j int) bool {
-		return podList.Items[i].CreationTimestamp.After(podList.Items[j].CreationTimestamp.Time)
+	if !app_.RemoteConfig.GetNoModifyConfig() {
+		pods = lo.Filter(pods, func(item corev1.Pod, index int) bool {
+			return item.Labels[configDebugLabel] == app_.Name
+		})
+	}
+	if app_.RemoteRuntime.WorkloadType == app.WorkloadType_STATEFULSET {
+		podName := workloads.StatefulSetPodName(app_.RemoteRuntime)
+		pods = lo.Filter(pods, func(item corev1.Pod, index int) bool {
+			return item.Name == podName
+		})
+	}
+	return newestPod(pods)
+}
+
+func newestPod(pods []corev1.Pod) (*corev1.Pod, error) {
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.After(pods[j].CreationTimestamp.Time)
 	})
-	if len(podList.Items) == 0 {
+	if len(pods) == 0 {
 		return nil, fmt.Errorf("no pod found")
 	}
-	return &podList.Items[0], nil
+	return &pods[0], nil
 }
 
 func (a *appManagement) setAppRelatedWorkloadTemplate(ctx context.Context, app_ *app.App, tmpl corev1.PodTemplateSpec) error {
-	switch app_.RemoteRuntime.WorkloadType {
-	case app.WorkloadType_DEPLOYMENT:
-		dep, err := a.kubeclient.AppsV1().Deployments(app_.RemoteRuntime.Namespace).Get(ctx, app_.RemoteRuntime.WorkloadName, metav1.GetOptions{})
-		if err != nil {
-			return err
-		}
-		dep.Spec.Template = tmpl
-		_, err = a.kubeclient.AppsV1().Deployments(app_.RemoteRuntime.Namespace).Update(ctx, dep, metav1.UpdateOptions{})
-		return err
-	case app.WorkloadType_DAEMONSET:
-		dep, err := a.kubeclient.AppsV1().DaemonSets(app_.RemoteRuntime.Namespace).Get(ctx, app_.RemoteRuntime.WorkloadName, metav1.GetOptions{})
-		if err != nil {
-			return err
-		}
-		dep.Spec.Template = tmpl
-		_, err = a.kubeclient.AppsV1().DaemonSets(app_.RemoteRuntime.Namespace).Update(ctx, dep, metav1.UpdateOptions{})
+	accessor, err := workloads.NewAccessor(a.kubeconfig, app_.RemoteRuntime)
+	if err != nil {
 		return err
 	}
-	return fmt.Errorf("unsupported workload type %s", app_.RemoteRuntime.WorkloadType.String())
+	return accessor.SetTemplate(ctx, app_.RemoteRuntime, tmpl)
 }
 
 func (a *appManagement) getApp(name string) (*app.App, bool) {
@@ -211,6 +207,9 @@ func (a *appManagement) CreateApp(ctx context.Context, app_ *app.App) (*app.App,
 		app_.RemoteRuntime.WorkloadName == "" {
 		return nil, fmt.Errorf("remote runtime namespace, workload type and workload name are required")
 	}
+	if _, err := workloads.GroupVersionKind(app_.RemoteRuntime); err != nil {
+		return nil, err
+	}
 	if app_.RemoteRuntime.TargetArch == app.ArchType_ARCH_TYPE_UNSPECIFIED {
 		app_.RemoteRuntime.TargetArch = app.ArchType_AMD64
 	}
@@ -236,6 +235,7 @@ func (a *appManagement) DeleteApp(ctx context.Context, request *app.SingleAppReq
 	if err := os.Remove(appFile(request.Name)); err != nil {
 		return nil, err
 	}
+	a.releaseDebugConfig(request.Name)
 	return app_, nil
 }
 
@@ -252,15 +252,7 @@ func (a *appManagement) GetAppStatus(ctx context.Context, request *app.SingleApp
 	if !ok {
 		return nil, fmt.Errorf("app %s not found", request.Name)
 	}
-	tmpl, err := a.getAppRelatedWorkloadTemplate(ctx, app_)
-	if err != nil {
-		return nil, err
-	}
-	configured := tmpl.Labels[configDebugLabel] == app_.Name
-	return &app.Status{
-		AppName:    app_.Name,
-		Configured: configured,
-	}, nil
+	return a.appStatus(ctx, app_)
 }
 
 // InitAppRemote will do the following things:
@@ -269,6 +261,7 @@ func (a *appManagement) GetAppStatus(ctx context.Context, request *app.SingleApp
 //     b. change the replica to 1 and other things.
 //  2. installing debug tool in container.
 //  3. port-forward the remote debugging port.
+//  4. watch the pods, the debug tool and the port-forward follow the replaced pod.
 func (a *appManagement) InitAppRemote(ctx context.Context, request *app.SingleAppRequest) (*app.Status, error) {
 	app_, ok := a.getApp(request.Name)
 	if !ok {
@@ -278,12 +271,20 @@ func (a *appManagement) InitAppRemote(ctx context.Context, request *app.SingleAp
 		return nil, fmt.Errorf("app %s is not configured local debuging config", request.Name)
 	}
 	if app_.RemoteConfig == nil {
-		app_.RemoteConfig = &app.RemoteConfig{
-			DebugToolPath:       "/tmp/debug-tool",
-			RemoteAppLocation:   "/tmp",
-			RemoteDebuggingPort: int32(rand.Int()%10000 + 50000),
-			InitialConfig:       "",
-		}
+		app_.RemoteConfig = &app.RemoteConfig{}
+	}
+	if app_.RemoteConfig.DebugToolPath == "" {
+		app_.RemoteConfig.DebugToolPath = "/tmp/debug-tool"
+	}
+	if app_.RemoteConfig.RemoteAppLocation == "" {
+		app_.RemoteConfig.RemoteAppLocation = "/tmp"
+	}
+	if app_.RemoteConfig.RemoteDebuggingPort == 0 {
+		app_.RemoteConfig.RemoteDebuggingPort = int32(rand.Int()%10000 + 50000)
+	}
+	if isEphemeralMode(app_) || (isAttachMode(app_) && !isCloneMode(app_)) {
+		// the debugger attaches to the running pods, the workload is never modified.
+		app_.RemoteConfig.NoModifyConfig = true
 	}
 	tmpl, err := a.getAppRelatedWorkloadTemplate(ctx, app_)
 	if err != nil {
@@ -292,11 +293,18 @@ func (a *appManagement) InitAppRemote(ctx context.Context, request *app.SingleAp
 	// 1. config the workload to ready for debug.
 	needUpdate := false
 	calcTarget := func(tmpl *corev1.PodTemplateSpec) {
+		if tmpl.Labels == nil {
+			tmpl.Labels = map[string]string{}
+		}
 		tmpl.Labels[configDebugLabel] = app_.Name
+		if isAttachMode(app_) {
+			// the clone runs the original command to be attached.
+			return
+		}
 		for i := range tmpl.Spec.Containers {
 			if tmpl.Spec.Containers[i].Name == app_.RemoteRuntime.ContainerName || app_.RemoteRuntime.ContainerName == "" {
 				tmpl.Spec.Containers[i].Command = []string{"/bin/sh"}
-				tmpl.Spec.Containers[i].Args = []string{"-c", "touch /tmp/mirage-debug-output; tail -f /tmp/mirage-debug-output"}
+				tmpl.Spec.Containers[i].Args = []string{"-c", fmt.Sprintf("touch %[1]s; tail -f %[1]s", debugOutputFile)}
 				if tmpl.Spec.Containers[i].SecurityContext != nil {
 					tmpl.Spec.Containers[i].SecurityContext.ReadOnlyRootFilesystem = pointer.Bool(false)
 				}
@@ -325,7 +333,12 @@ func (a *appManagement) InitAppRemote(ctx context.Context, request *app.SingleAp
 		}
 		needUpdate = true
 	}
-	if needUpdate && !app_.RemoteConfig.GetNoModifyConfig() {
+	if isCloneMode(app_) {
+		// the clone is debugged, the workload keeps serving.
+		if err := a.applyClone(ctx, app_, *tmpl); err != nil {
+			return nil, err
+		}
+	} else if needUpdate && !app_.RemoteConfig.GetNoModifyConfig() {
 		if err := a.setAppRelatedWorkloadTemplate(ctx, app_, *tmpl); err != nil {
 			return nil, err
 		}
@@ -333,63 +346,60 @@ func (a *appManagement) InitAppRemote(ctx context.Context, request *app.SingleAp
 	if app_.RemoteRuntime.ContainerName == "" {
 		app_.RemoteRuntime.ContainerName = tmpl.Spec.Containers[0].Name
 	}
-	timer := time.NewTimer(time.Second * 3)
-	defer timer.Stop()
+	ticker := time.NewTicker(time.Second * 3)
+	defer ticker.Stop()
 	ctx, cancel := context.WithTimeout(ctx, time.Second*60)
 	defer cancel()
 	// wait for the workload to be ready.
-	podName := ""
+	var pod *corev1.Pod
 	var lastErr error
 loop:
 	for {
 		select {
-		case <-timer.C:
-			pod, err := a.getAppRelatedPod(ctx, app_)
+		case <-ticker.C:
+			p, err := a.getAppRelatedPod(ctx, app_)
 			if err != nil {
 				lastErr = err
 				log.Errorf("get pod of app %s failed: %v", app_.Name, err)
 			} else {
-				if pod.Status.Phase == corev1.PodRunning {
-					podName = pod.Name
+				if p.Status.Phase == corev1.PodRunning {
+					pod = p
 					break loop
 				} else {
-					log.Debugf("pod %s is not running", pod.Name)
-					lastErr = fmt.Errorf("pod %s is not running", pod.Name)
+					log.Debugf("pod %s is not running", p.Name)
+					lastErr = fmt.Errorf("pod %s is not running", p.Name)
 				}
 			}
 		case <-ctx.Done():
-			lastErr = fmt.Errorf("wait for pod running timeout")
+			if lastErr == nil {
+				lastErr = fmt.Errorf("wait for pod running timeout")
+			}
+			break loop
 		}
 	}
-	if podName == "" {
+	if pod == nil {
 		return nil, lastErr
 	}
+	podName := pod.Name
 	// 2. installing debug tool in container.
-	if err := debug_tools.InstallPodDebugTool(ctx, app_, a.kubeconfig, podName); err != nil {
+	container := app_.RemoteRuntime.ContainerName
+	if isEphemeralMode(app_) {
+		container, err = a.ensureEphemeralDebugger(ctx, app_, pod)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := debug_tools.InstallPodDebugTool(ctx, app_, a.kubeconfig, podName, container); err != nil {
 		return nil, err
 	}
 	a.save(app_)
 	// 3. port-forward the remote debugging port.
-	if debugConifg, ok := a.debugConfigMap[app_.Name]; !ok {
-		pf := kube.NewPodPortForwarder(a.kubeconfig, app_.RemoteRuntime.Namespace, podName, app_.RemoteConfig.RemoteDebuggingPort, app_.RemoteConfig.RemoteDebuggingPort)
-		if err := pf.Start(); err != nil {
-			return nil, err
-		}
-		a.debugConfigMap[app_.Name] = appDebugConfig{
-			port:             app_.RemoteConfig.RemoteDebuggingPort,
-			podPortForwarder: pf,
-		}
-	} else if debugConifg.port != app_.RemoteConfig.RemoteDebuggingPort ||
-		debugConifg.podPortForwarder.PodName() != podName {
-		debugConifg.podPortForwarder.Stop()
-		pf := kube.NewPodPortForwarder(a.kubeconfig, app_.RemoteRuntime.Namespace, podName, app_.RemoteConfig.RemoteDebuggingPort, app_.RemoteConfig.RemoteDebuggingPort)
-		if err := pf.Start(); err != nil {
-			return nil, err
-		}
-		a.debugConfigMap[app_.Name] = appDebugConfig{
-			port:             app_.RemoteConfig.RemoteDebuggingPort,
-			podPortForwarder: pf,
-		}
+	if err := a.forwardPort(app_, podName); err != nil {
+		return nil, err
+	}
+	// 4. follow the pod when it is replaced.
+	if err := a.watchPods(ctx, app_, pod); err != nil {
+		log.Errorf("watch pods of app %s failed: %v", app_.Name, err)
 	}
 	return &app.Status{
 		AppName:    app_.Name,
@@ -409,37 +419,67 @@ func (a *appManagement) StartDebugging(ctx context.Context, request *app.SingleA
 	if err != nil {
 		return nil, err
 	}
-	binaryFile := app_.LocalConfig.BuildOutput
-	if !strings.HasPrefix(binaryFile, "/") {
-		binaryFile = path.Join(app_.LocalConfig.WorkingDir, app_.LocalConfig.BuildOutput)
+	container, err := debuggerContainer(app_, pod)
+	if err != nil {
+		return nil, err
 	}
-	if err := kube.CopyLocalFileToPod(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, pod.Name, app_.RemoteRuntime.ContainerName, binaryFile, "", app_.RemoteConfig.RemoteAppLocation); err != nil {
+	// the port-forward and the pod watcher are gone after the server restarts.
+	if err := a.forwardPort(app_, pod.Name); err != nil {
 		return nil, err
 	}
-	var langAdaptor langadaptors.LanguageAdaptor
-	switch app_.ProgramType {
-	case app.ProgramType_GO:
-		langAdaptor = golang.NewGolangAdaptor()
-	case app.ProgramType_RUST:
-		langAdaptor = rust.NewRustAdaptor()
-	default:
-		return nil, fmt.Errorf("unsupported program type %s", app_.ProgramType)
+	if c, ok := a.getDebugConfig(app_.Name); !ok || c.podWatcher == nil {
+		if err := a.watchPods(ctx, app_, pod); err != nil {
+			log.Errorf("watch pods of app %s failed: %v", app_.Name, err)
+		}
 	}
-	command, err := langAdaptor.DebugCommand(app_)
+	langAdaptor, err := langadaptors.NewLanguageAdaptor(app_)
 	if err != nil {
 		return nil, err
 	}
-	kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, pod.Name, app_.RemoteRuntime.ContainerName,
-		fmt.Sprintf("pkill -9 %s; pkill -9 %s", path.Base(app_.RemoteConfig.DebugToolPath), path.Base(app_.LocalConfig.BuildOutput)),
-		nil)
+	var command string
+	if isAttachMode(app_) {
+		// attach to the running process, only the previous debugger should be killed.
+		pid, err := a.findTargetPID(ctx, app_, pod.Name, container)
+		if err != nil {
+			return nil, err
+		}
+		command, err = langAdaptor.AttachCommand(app_, pid)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		binaryFile := app_.LocalConfig.BuildOutput
+		if !strings.HasPrefix(binaryFile, "/") {
+			binaryFile = path.Join(app_.LocalConfig.WorkingDir, app_.LocalConfig.BuildOutput)
+		}
+		if err := kube.CopyLocalFileToPod(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, pod.Name, container, binaryFile, "", app_.RemoteConfig.RemoteAppLocation); err != nil {
+			return nil, err
+		}
+		command, err = langAdaptor.DebugCommand(app_)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := a.killDebugging(ctx, app_, pod.Name, container, defaultStopGracePeriod); err != nil {
+		return nil, err
+	}
+	offset := a.debugOutputSize(ctx, app_, pod.Name, container)
+	exited := make(chan error, 1)
 	go func() {
-		_, _, err = kube.ExecutePodCmd(context.Background(), a.kubeconfig, app_.RemoteRuntime.Namespace, pod.Name, app_.RemoteRuntime.ContainerName, fmt.Sprintf("%s 2>&1 >>/tmp/mirage-debug-output", command), nil)
+		_, _, err := kube.ExecutePodCmd(context.Background(), a.kubeconfig, app_.RemoteRuntime.Namespace, pod.Name, container, launchCommand(command), nil)
 		if err != nil {
-			log.Errorf("start debugging failed: %v", err)
+			log.Debugf("debugger of app %s exited: %v", app_.Name, err)
 		}
+		exited <- err
 	}()
-	<-time.After(time.Second * 3)
-	return &app.Empty{}, err
+	if err := a.waitDebuggerReady(ctx, app_, langAdaptor, pod.Name, container, exited); err != nil {
+		return nil, fmt.Errorf("debugger of app %s is not ready: %v\nstartup output:\n%s",
+			app_.Name, err, a.debugOutputSince(ctx, app_, pod.Name, container, offset))
+	}
+	a.updateDebugConfig(app_.Name, func(c *appDebugConfig) {
+		c.debugging = true
+	})
+	return &app.Empty{}, nil
 }
 
 func (a *appManagement) RollbackApp(ctx context.Context, request *app.SingleAppRequest) (*app.Status, error) {
@@ -447,6 +487,28 @@ func (a *appManagement) RollbackApp(ctx context.Context, request *app.SingleAppR
 	if !ok {
 		return nil, fmt.Errorf("app %s not found", request.Name)
 	}
+	if app_.GetRemoteConfig().GetNoModifyConfig() && !isCloneMode(app_) {
+		// the workload is never modified, writing the initial config back would undo
+		// the rollouts after init. Only the debugger is stopped, the ephemeral debugger
+		// container can not be removed and is gone with the pod.
+		if err := a.stopDebugging(ctx, app_, defaultStopGracePeriod); err != nil {
+			log.Debugf("stop debugging of app %s failed: %v", app_.Name, err)
+		}
+		a.releaseDebugConfig(app_.Name)
+		return &app.Status{
+			AppName: app_.Name,
+		}, nil
+	}
+	// the pods are not debugged any more.
+	a.releaseDebugConfig(app_.Name)
+	if isCloneMode(app_) {
+		if err := a.deleteClone(ctx, app_); err != nil {
+			return nil, err
+		}
+		return &app.Status{
+			AppName: app_.Name,
+		}, nil
+	}
 	if app_.GetRemoteConfig().GetInitialConfig() == "" {
 		return nil, fmt.Errorf("no initial config found")
 	}
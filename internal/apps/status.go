@@ -0,0 +1,187 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/internal/kube"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+const (
+	statusWatchInterval = time.Second * 3
+)
+
+func (a *appManagement) getDebugConfig(name string) (appDebugConfig, bool) {
+	a.rwlock.RLock()
+	defer a.rwlock.RUnlock()
+	c, ok := a.debugConfigMap[name]
+	return c, ok
+}
+
+// appEvent is the latest event of an app, the watchers wait on changed, which is closed
+// and replaced when a new event is emitted.
+type appEvent struct {
+	message string
+	changed chan struct{}
+}
+
+func (a *appManagement) eventOf(name string) *appEvent {
+	e, ok := a.events[name]
+	if !ok {
+		e = &appEvent{changed: make(chan struct{})}
+		a.events[name] = e
+	}
+	return e
+}
+
+// emitEvent records the event of the app and wakes up the status watchers.
+func (a *appManagement) emitEvent(name string, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Infof("app %s: %s", name, message)
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	e := a.eventOf(name)
+	e.message = message
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+// lastEvent returns the latest event of the app, and the channel closed on the next event.
+func (a *appManagement) lastEvent(name string) (string, <-chan struct{}) {
+	a.rwlock.Lock()
+	defer a.rwlock.Unlock()
+	e := a.eventOf(name)
+	return e.message, e.changed
+}
+
+func (a *appManagement) isConfigured(ctx context.Context, app_ *app.App) (bool, error) {
+	if isCloneMode(app_) {
+		return a.cloneExists(ctx, app_)
+	}
+	tmpl, err := a.getAppRelatedWorkloadTemplate(ctx, app_)
+	if err != nil {
+		return false, err
+	}
+	return tmpl.Labels[configDebugLabel] == app_.Name, nil
+}
+
+// podError returns why the container of the app in the pod is not working, empty means it is working.
+func podError(pod *corev1.Pod, container string) string {
+	if pod.Status.Phase != corev1.PodRunning {
+		return fmt.Sprintf("pod %s is %s", pod.Name, pod.Status.Phase)
+	}
+	for _, s := range pod.Status.ContainerStatuses {
+		if s.Name != container && container != "" {
+			continue
+		}
+		if s.State.Waiting != nil {
+			return fmt.Sprintf("container %s is waiting: %s", s.Name, s.State.Waiting.Reason)
+		}
+		if s.RestartCount > 0 && s.LastTerminationState.Terminated != nil &&
+			s.State.Running != nil && s.State.Running.StartedAt.Time.After(time.Now().Add(-statusWatchInterval*10)) {
+			// restarted recently, the debugger and the app launched by it are gone.
+			return fmt.Sprintf("container %s restarted %d times, last terminated: %s", s.Name, s.RestartCount, s.LastTerminationState.Terminated.Reason)
+		}
+	}
+	return ""
+}
+
+// debuggerRunning returns whether the process group of the debug tool recorded in debugPIDFile is alive.
+// The launcher of a one-shot attach exits once the debugger is activated in the process,
+// so the debugger is probed instead.
+func (a *appManagement) debuggerRunning(ctx context.Context, app_ *app.App, podName, container string) bool {
+	if isOneShotAttach(app_) {
+		langAdaptor, err := langadaptors.NewLanguageAdaptor(app_)
+		if err != nil {
+			return false
+		}
+		return a.probeDebugger(ctx, app_, langAdaptor, podName, container) == nil
+	}
+	stdout, _, err := kube.ExecutePodCmd(ctx, a.kubeconfig, app_.RemoteRuntime.Namespace, podName, container,
+		fmt.Sprintf(`pgid=$(cat %s 2>/dev/null); [ -n "$pgid" ] && kill -0 -$pgid 2>/dev/null && echo $pgid; true`, debugPIDFile), nil)
+	if err != nil {
+		log.Debugf("find debugger of app %s in pod %s failed: %v", app_.Name, podName, err)
+		return false
+	}
+	return strings.TrimSpace(string(stdout)) != ""
+}
+
+// appStatus computes the status of the app from the workload, the port-forward and
+// the debugger in the pod. The errors of the pod are reported in the Error field.
+func (a *appManagement) appStatus(ctx context.Context, app_ *app.App) (*app.Status, error) {
+	configured, err := a.isConfigured(ctx, app_)
+	if err != nil {
+		return nil, err
+	}
+	event, _ := a.lastEvent(app_.Name)
+	status := &app.Status{
+		AppName:       app_.Name,
+		Configured:    configured,
+		DebugToolPath: app_.GetRemoteConfig().GetDebugToolPath(),
+		Event:         event,
+	}
+	if app_.GetRemoteConfig().GetDebugToolPath() == "" {
+		// the remote is not inited yet.
+		return status, nil
+	}
+	pod, err := a.getAppRelatedPod(ctx, app_)
+	if err != nil {
+		status.Error = err.Error()
+		return status, nil
+	}
+	status.Error = podError(pod, app_.RemoteRuntime.ContainerName)
+	if c, ok := a.getDebugConfig(app_.Name); ok && c.podPortForwarder != nil {
+		status.Connected = c.podPortForwarder.PodName() == pod.Name && c.podPortForwarder.Connected()
+	}
+	if pod.Status.Phase == corev1.PodRunning {
+		container, err := debuggerContainer(app_, pod)
+		if err != nil {
+			status.Error = err.Error()
+			return status, nil
+		}
+		status.Debugging = a.debuggerRunning(ctx, app_, pod.Name, container)
+	}
+	return status, nil
+}
+
+func (a *appManagement) WatchAppStatus(request *app.SingleAppRequest, stream app.AppManagement_WatchAppStatusServer) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(statusWatchInterval)
+	defer ticker.Stop()
+	var last *app.Status
+	for {
+		app_, ok := a.getApp(request.Name)
+		if !ok {
+			return fmt.Errorf("app %s not found", request.Name)
+		}
+		_, changed := a.lastEvent(app_.Name)
+		status, err := a.appStatus(ctx, app_)
+		if err != nil {
+			// the workload may be recreated, keep watching.
+			status = &app.Status{
+				AppName: app_.Name,
+				Error:   err.Error(),
+			}
+		}
+		if last == nil || !proto.Equal(last, status) {
+			if err := stream.Send(status); err != nil {
+				return err
+			}
+			last = status
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-changed:
+		}
+	}
+}
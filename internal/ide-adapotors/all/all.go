@@ -0,0 +1,11 @@
+// Package all registers all the builtin IDE adaptors.
+package all
+
+import (
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/emacs"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/helix"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/jetbrains"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/neovim"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/vscode"
+	_ "github.com/miragedebug/miragedebug/internal/ide-adapotors/zed"
+)
@@ -0,0 +1,61 @@
+package ideadapotors
+
+import (
+	"fmt"
+	"strings"
+)
+
+func markers(comment, name string) (string, string) {
+	return fmt.Sprintf("%s mirage:%s begin", comment, name), fmt.Sprintf("%s mirage:%s end", comment, name)
+}
+
+// blockRange returns the range of the block of the app in content, including the marker lines.
+func blockRange(content, comment, name string) (int, int, bool) {
+	beginMarker, endMarker := markers(comment, name)
+	begin := strings.Index(content, beginMarker)
+	end := strings.Index(content, endMarker)
+	if begin < 0 || end < begin {
+		return 0, 0, false
+	}
+	end += len(endMarker)
+	if end < len(content) && content[end] == '\n' {
+		end++
+	}
+	return begin, end, true
+}
+
+// ReplaceBlock replaces the block of the app in content, or appends it if absent.
+// Blocks are delimited by marker lines starting with comment, so the content
+// written by users and other apps is kept.
+func ReplaceBlock(content, comment, name, block string) string {
+	beginMarker, endMarker := markers(comment, name)
+	block = beginMarker + "\n" + block + endMarker + "\n"
+	begin, end, ok := blockRange(content, comment, name)
+	if !ok {
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		return content + block
+	}
+	return content[:begin] + block + content[end:]
+}
+
+// GetBlock returns the block of the app in content, excluding the marker lines.
+func GetBlock(content, comment, name string) (string, bool) {
+	begin, end, ok := blockRange(content, comment, name)
+	if !ok {
+		return "", false
+	}
+	beginMarker, endMarker := markers(comment, name)
+	block := strings.TrimPrefix(content[begin:end], beginMarker+"\n")
+	return strings.TrimSuffix(strings.TrimSuffix(block, "\n"), endMarker), true
+}
+
+// RemoveBlock removes the block of the app in content.
+func RemoveBlock(content, comment, name string) string {
+	begin, end, ok := blockRange(content, comment, name)
+	if !ok {
+		return content
+	}
+	return content[:begin] + content[end:]
+}
@@ -0,0 +1,49 @@
+package ideadapotors
+
+import (
+	"testing"
+)
+
+func TestBlock(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		block       string
+		wantReplace string
+		wantRemove  string
+	}{
+		{
+			name:        "empty",
+			block:       "a = 1\n",
+			wantReplace: "-- mirage:app begin\na = 1\n-- mirage:app end\n",
+		},
+		{
+			name:        "append to user content",
+			content:     "b = 2",
+			block:       "a = 1\n",
+			wantReplace: "b = 2\n-- mirage:app begin\na = 1\n-- mirage:app end\n",
+			wantRemove:  "b = 2",
+		},
+		{
+			name:        "replace and keep others",
+			content:     "b = 2\n-- mirage:app begin\na = 0\n-- mirage:app end\n-- mirage:other begin\nc = 3\n-- mirage:other end\n",
+			block:       "a = 1\n",
+			wantReplace: "b = 2\n-- mirage:app begin\na = 1\n-- mirage:app end\n-- mirage:other begin\nc = 3\n-- mirage:other end\n",
+			wantRemove:  "b = 2\n-- mirage:other begin\nc = 3\n-- mirage:other end\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReplaceBlock(tt.content, "--", "app", tt.block)
+			if got != tt.wantReplace {
+				t.Errorf("ReplaceBlock() = %q, want %q", got, tt.wantReplace)
+			}
+			if block, ok := GetBlock(got, "--", "app"); !ok || block != tt.block {
+				t.Errorf("GetBlock() = %q, %v, want %q", block, ok, tt.block)
+			}
+			if got := RemoveBlock(tt.content, "--", "app"); got != tt.wantRemove {
+				t.Errorf("RemoveBlock() = %q, want %q", got, tt.wantRemove)
+			}
+		})
+	}
+}
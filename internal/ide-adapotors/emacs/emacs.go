@@ -0,0 +1,155 @@
+package emacs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+)
+
+const (
+	configFile = "mirage-dape.el"
+	header     = `;;; mirage-dape.el --- MirageDebug configurations for dape and dap-mode -*- lexical-binding: t -*-
+;; Generated by MirageDebug, load it with (load-file "mirage-dape.el").
+`
+)
+
+type emacsAdaptor struct {
+}
+
+func NewEmacsAdaptor() ideadapotors.IDEAdaptor {
+	return &emacsAdaptor{}
+}
+
+func init() {
+	ideadapotors.Register(app.IDEType_EMACS.String(), NewEmacsAdaptor)
+}
+
+// both dape and dap-mode run the compile command before connecting,
+// like the preLaunchTask of VS Code.
+var configTmpl = template.Must(template.New("config").Parse(`(with-eval-after-load 'dape
+  (add-to-list 'dape-configs
+               '({{ .Adapter }}
+{{- if eq .Debugger "dlv" }}
+                 modes (go-mode go-ts-mode)
+                 host "127.0.0.1"
+                 port {{ .Port }}
+                 compile {{ .Prepare }}
+                 :type "go"
+                 :request "attach"
+                 :mode "remote"
+{{- if .Mappings }}
+                 :substitutePath [{{ range .Mappings }}(:from {{ .From }} :to {{ .To }}){{ end }}]
+{{- end }})))
+{{- else }}
+                 modes ({{ .Language }}-mode {{ .Language }}-ts-mode)
+                 command {{ .GDB }}
+                 command-args ("--interpreter=dap")
+                 compile {{ .Prepare }}
+                 :request "attach"
+                 :target "127.0.0.1:{{ .Port }}"
+                 :program {{ .Program }}
+                 :cwd {{ .WorkingDir }})))
+{{- end }}
+(with-eval-after-load 'dap-mode
+  (dap-register-debug-template
+   {{ .Label }}
+{{- if eq .Debugger "dlv" }}
+   (list :type "go"
+         :request "attach"
+         :mode "remote"
+         :host "127.0.0.1"
+         :port {{ .Port }}
+{{- else }}
+   (list :type "gdbserver"
+         :request "attach"
+         :target "127.0.0.1:{{ .Port }}"
+         :gdbpath {{ .GDB }}
+         :executable {{ .Program }}
+         :cwd {{ .WorkingDir }}
+{{- end }}
+         :dap-compilation {{ .Prepare }}
+         :dap-compilation-dir {{ .WorkingDir }})))
+`))
+
+func (e *emacsAdaptor) renderConfig(a *app.App) (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	var language, debugger string
+	switch a.ProgramType {
+	case app.ProgramType_GO:
+		language, debugger = "go", "dlv"
+	case app.ProgramType_RUST:
+		language, debugger = "rust", "gdb"
+	case app.ProgramType_CPP:
+		language, debugger = "c++", "gdb"
+	default:
+		return "", fmt.Errorf("emacs does not support program type %s yet", app.ProgramTypeName(a))
+	}
+	gdb := a.LocalConfig.Metadata["gdbpath"]
+	if gdb == "" {
+		gdb = "gdb"
+	}
+	program := a.LocalConfig.BuildOutput
+	if !path.IsAbs(program) {
+		program = path.Join(a.LocalConfig.WorkingDir, program)
+	}
+	buf := bytes.NewBuffer(nil)
+	err = configTmpl.Execute(buf, map[string]interface{}{
+		"Adapter":    "mirage-" + a.Name,
+		"Label":      strconv.Quote(fmt.Sprintf("Remote debug %s", a.Name)),
+		"Prepare":    strconv.Quote(fmt.Sprintf("%s debug %s", exe, a.Name)),
+		"Language":   language,
+		"Debugger":   debugger,
+		"Port":       a.RemoteConfig.RemoteDebuggingPort,
+		"WorkingDir": strconv.Quote(a.LocalConfig.WorkingDir),
+		"Mappings":   ideadapotors.SubstitutePath(langadaptors.SourceMappings(a)),
+		"Program":    strconv.Quote(program),
+		"GDB":        strconv.Quote(gdb),
+	})
+	return buf.String(), err
+}
+
+func (e *emacsAdaptor) PrepareLaunch(a *app.App) error {
+	pwd, _ := os.Getwd()
+	if pwd != a.LocalConfig.WorkingDir {
+		return fmt.Errorf("you are not in the project root directory(%s)", a.LocalConfig.WorkingDir)
+	}
+	config, err := e.renderConfig(a)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if !strings.HasPrefix(string(content), header) {
+		content = append([]byte(header), content...)
+	}
+	return os.WriteFile(configFile, []byte(ideadapotors.ReplaceBlock(string(content), ";;", a.Name, config)), 0644)
+}
+
+func (e *emacsAdaptor) Cleanup(a *app.App) error {
+	f := path.Join(a.LocalConfig.WorkingDir, configFile)
+	content, err := os.ReadFile(f)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	rest := ideadapotors.RemoveBlock(string(content), ";;", a.Name)
+	if strings.TrimSpace(strings.TrimPrefix(rest, header)) == "" {
+		return os.Remove(f)
+	}
+	return os.WriteFile(f, []byte(rest), 0644)
+}
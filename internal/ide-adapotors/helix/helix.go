@@ -0,0 +1,186 @@
+package helix
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+)
+
+type helixAdaptor struct {
+}
+
+func NewHelixAdaptor() ideadapotors.IDEAdaptor {
+	return &helixAdaptor{}
+}
+
+func init() {
+	ideadapotors.Register(app.IDEType_HELIX.String(), NewHelixAdaptor)
+}
+
+// helix always spawns the debug adapter and has no pre-launch task,
+// so the adapter is a shell running mirage-debug debug first, then talking
+// DAP over stdio to the forwarded port (dlv) or a local gdb (gdbserver).
+// helix allows one debugger per language, the latest prepared app wins.
+// The debugger is merged into the table of the language if users have configured it.
+var debuggerTmpl = template.Must(template.New("debugger").Parse(`[language.debugger]
+name = {{ .Adapter }}
+transport = "stdio"
+command = "sh"
+args = ["-c", {{ .Command }}]
+
+[[language.debugger.templates]]
+name = {{ .Label }}
+request = "attach"
+completion = []
+{{- if eq .Language "go" }}
+args = { mode = "remote" }
+{{- else }}
+args = { target = "127.0.0.1:{{ .Port }}", program = {{ .Program }} }
+{{- end }}
+`))
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (h *helixAdaptor) renderLanguage(a *app.App) (string, string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", "", err
+	}
+	// the output of mirage-debug must not mix with the DAP messages on stdout.
+	prepare := fmt.Sprintf("%s debug %s >&2", shellQuote(exe), shellQuote(a.Name))
+	var language, command string
+	switch a.ProgramType {
+	case app.ProgramType_GO:
+		language = "go"
+		command = fmt.Sprintf("%s && exec nc 127.0.0.1 %d", prepare, a.RemoteConfig.RemoteDebuggingPort)
+	case app.ProgramType_RUST, app.ProgramType_CPP:
+		language = "rust"
+		if a.ProgramType == app.ProgramType_CPP {
+			language = "cpp"
+		}
+		gdb := a.LocalConfig.Metadata["gdbpath"]
+		if gdb == "" {
+			gdb = "gdb"
+		}
+		command = fmt.Sprintf("%s && exec %s --interpreter=dap", prepare, shellQuote(gdb))
+	default:
+		return "", "", fmt.Errorf("helix does not support program type %s yet", app.ProgramTypeName(a))
+	}
+	program := a.LocalConfig.BuildOutput
+	if !path.IsAbs(program) {
+		program = path.Join(a.LocalConfig.WorkingDir, program)
+	}
+	buf := bytes.NewBuffer(nil)
+	err = debuggerTmpl.Execute(buf, map[string]interface{}{
+		"Language": language,
+		"Adapter":  strconv.Quote("mirage-" + a.Name),
+		"Label":    strconv.Quote(fmt.Sprintf("Remote debug %s", a.Name)),
+		"Command":  strconv.Quote(command),
+		"Port":     a.RemoteConfig.RemoteDebuggingPort,
+		"Program":  strconv.Quote(program),
+	})
+	return language, buf.String(), err
+}
+
+func (h *helixAdaptor) PrepareLaunch(a *app.App) error {
+	pwd, _ := os.Getwd()
+	if pwd != a.LocalConfig.WorkingDir {
+		return fmt.Errorf("you are not in the project root directory(%s)", a.LocalConfig.WorkingDir)
+	}
+	language, block, err := h.renderLanguage(a)
+	if err != nil {
+		return err
+	}
+	languagesFile := path.Join(".helix", "languages.toml")
+	content, err := os.ReadFile(languagesFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	os.MkdirAll(path.Dir(languagesFile), 0755)
+	merged, err := mergeDebugger(string(content), language, block)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(languagesFile, []byte(merged), 0644)
+}
+
+// mergeDebugger puts the debugger block into the table of the language configured by users,
+// or appends a table of the language with it. The block is keyed by the language,
+// as a language has only one debugger.
+func mergeDebugger(content, language, block string) (string, error) {
+	name := "language-" + language
+	rest := ideadapotors.RemoveBlock(content, "#", name)
+	begin, end, ok := languageTable(rest, language)
+	if !ok {
+		return ideadapotors.ReplaceBlock(content, "#", name, fmt.Sprintf("[[language]]\nname = %s\n\n%s", strconv.Quote(language), block)), nil
+	}
+	if strings.Contains(rest[begin:end], "[language.debugger]") {
+		return "", fmt.Errorf("the debugger of %s is configured in languages.toml already", language)
+	}
+	table := rest[:end]
+	if !strings.HasSuffix(table, "\n") {
+		table += "\n"
+	}
+	return ideadapotors.ReplaceBlock(table, "#", name, block) + rest[end:], nil
+}
+
+// languageTable returns the range of the [[language]] table named language in content,
+// including its sub tables such as [language.debugger].
+func languageTable(content, language string) (int, int, bool) {
+	begin, offset := -1, 0
+	// main is whether the line is in the [[language]] table itself rather than its sub tables.
+	main, found := false, false
+	for _, line := range strings.SplitAfter(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "[language.") || strings.HasPrefix(trimmed, "[[language."):
+			main = false
+		case strings.HasPrefix(trimmed, "["):
+			if found {
+				return begin, offset, true
+			}
+			begin, main = -1, trimmed == "[[language]]"
+			if main {
+				begin = offset
+			}
+		case main:
+			if key, value, ok := strings.Cut(trimmed, "="); ok && strings.TrimSpace(key) == "name" {
+				found = strings.Trim(strings.TrimSpace(value), `"'`) == language
+			}
+		}
+		offset += len(line)
+	}
+	return begin, offset, found
+}
+
+func (h *helixAdaptor) Cleanup(a *app.App) error {
+	languagesFile := path.Join(a.LocalConfig.WorkingDir, ".helix", "languages.toml")
+	content, err := os.ReadFile(languagesFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	adapter := fmt.Sprintf("name = %s\n", strconv.Quote("mirage-"+a.Name))
+	rest := string(content)
+	for _, language := range []string{"go", "rust", "cpp"} {
+		// the debugger of the language may belong to another app.
+		if block, ok := ideadapotors.GetBlock(rest, "#", "language-"+language); ok && strings.Contains(block, adapter) {
+			rest = ideadapotors.RemoveBlock(rest, "#", "language-"+language)
+		}
+	}
+	if strings.TrimSpace(rest) == "" {
+		return os.Remove(languagesFile)
+	}
+	return os.WriteFile(languagesFile, []byte(rest), 0644)
+}
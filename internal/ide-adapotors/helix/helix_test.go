@@ -0,0 +1,48 @@
+package helix
+
+import (
+	"testing"
+)
+
+func TestMergeDebugger(t *testing.T) {
+	const block = "[language.debugger]\nname = \"mirage-app\"\n"
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: "# mirage:language-go begin\n[[language]]\nname = \"go\"\n\n" + block + "# mirage:language-go end\n",
+		},
+		{
+			name:    "user table",
+			content: "[[language]]\nname = \"go\"\nauto-format = true\n\n[[language]]\nname = \"rust\"\n",
+			want: "[[language]]\nname = \"go\"\nauto-format = true\n\n# mirage:language-go begin\n" + block + "# mirage:language-go end\n" +
+				"[[language]]\nname = \"rust\"\n",
+		},
+		{
+			name: "regenerated in user table",
+			content: "[[language]]\nname = \"go\"\n[language.auto-pairs]\n'(' = ')'\n# mirage:language-go begin\n" + "[language.debugger]\nname = \"mirage-old\"\n" +
+				"# mirage:language-go end\n",
+			want: "[[language]]\nname = \"go\"\n[language.auto-pairs]\n'(' = ')'\n# mirage:language-go begin\n" + block + "# mirage:language-go end\n",
+		},
+		{
+			name:    "user debugger",
+			content: "[[language]]\nname = \"go\"\n\n[language.debugger]\nname = \"dlv\"\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeDebugger(tt.content, "go", block)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mergeDebugger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mergeDebugger() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
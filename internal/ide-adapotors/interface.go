@@ -5,4 +5,6 @@ import "github.com/miragedebug/miragedebug/api/app"
 type IDEAdaptor interface {
 	// PrepareLaunch prepares the config for the IDE to launch the debugger
 	PrepareLaunch(a *app.App) error
+	// Cleanup removes the config created by PrepareLaunch, the config of users and other apps is kept.
+	Cleanup(a *app.App) error
 }
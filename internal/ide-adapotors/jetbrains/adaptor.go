@@ -2,11 +2,15 @@ package jetbrains
 
 import (
 	"fmt"
+	"html"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/miragedebug/miragedebug/api/app"
 	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/internal/lang-adaptors/node"
 )
 
 const prepareScriptName = "Mirage - Prepare"
@@ -18,16 +22,58 @@ func NewJetbrainsAdaptor() ideadapotors.IDEAdaptor {
 	return &jetbrainsAdaptor{}
 }
 
+func init() {
+	for _, t := range []app.IDEType{
+		app.IDEType_GOLAND,
+		app.IDEType_CLION,
+		app.IDEType_PYCHARM,
+		app.IDEType_WEBSTORM,
+		app.IDEType_INTELLIJ,
+		app.IDEType_RIDER,
+	} {
+		ideadapotors.Register(t.String(), NewJetbrainsAdaptor)
+	}
+}
+
 func (j *jetbrainsAdaptor) initPreloadScript(name string) error {
+	return j.initShellConfig(fmt.Sprintf("%s %s", prepareScriptName, name), "debug", name)
+}
+
+// postDebugConfigName returns the name of the shell config running the mirage-debug command,
+// such as "Mirage - Stop a".
+func postDebugConfigName(command, name string) string {
+	return fmt.Sprintf("Mirage - %s%s %s", strings.ToUpper(command[:1]), command[1:], name)
+}
+
+// initPostDebugScript writes the shell config to run when the debug session ends.
+// JetBrains IDEs have no after launch step, so it is run by users manually.
+func (j *jetbrainsAdaptor) initPostDebugScript(a *app.App) error {
+	command := ideadapotors.PostDebugCommand(a)
+	for _, c := range ideadapotors.PostDebugCommands {
+		if c == command {
+			continue
+		}
+		// the config of the previous stop behavior.
+		f := path.Join(".run", fmt.Sprintf("%s.run.xml", postDebugConfigName(c, a.Name)))
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if command == "" {
+		return nil
+	}
+	return j.initShellConfig(postDebugConfigName(command, a.Name), command, a.Name)
+}
+
+func (j *jetbrainsAdaptor) initShellConfig(configName, command, name string) error {
 	exe, err := os.Executable()
 	if err != nil {
 		return err
 	}
-	configName := fmt.Sprintf("%s %s", prepareScriptName, name)
 	runTmpl := `
 <component name="ProjectRunConfigurationManager">
   <configuration default="false" name="%s" type="ShConfigurationType">
-    <option name="SCRIPT_TEXT" value="%s debug %s" />
+    <option name="SCRIPT_TEXT" value="%s %s %s" />
     <option name="INDEPENDENT_SCRIPT_PATH" value="true" />
     <option name="SCRIPT_PATH" value="" /> 
     <option name="SCRIPT_OPTIONS" value="" />
@@ -43,7 +89,7 @@ func (j *jetbrainsAdaptor) initPreloadScript(name string) error {
   </configuration>
 </component>
 `
-	xml := fmt.Sprintf(runTmpl, configName, exe, name)
+	xml := fmt.Sprintf(runTmpl, configName, exe, command, name)
 	f := path.Join(".run", fmt.Sprintf("%s.run.xml", configName))
 	os.MkdirAll(path.Dir(f), 0755)
 	if err := os.WriteFile(f, []byte(xml), 0644); err != nil {
@@ -52,19 +98,31 @@ func (j *jetbrainsAdaptor) initPreloadScript(name string) error {
 	return nil
 }
 
-func (j *jetbrainsAdaptor) initGolandRunRemoteConfig(name string, port int32) error {
+// pathMappingsXML renders the mappings from the paths recorded in the binary to the local paths.
+func pathMappingsXML(mappings []*app.SourceMapping) string {
+	if len(mappings) == 0 {
+		return ""
+	}
+	pathMappings := "\n    <path-mappings>"
+	for _, m := range mappings {
+		pathMappings += fmt.Sprintf("\n      <mapping remote=\"%s\" local=\"%s\" />", html.EscapeString(m.RemotePath), html.EscapeString(m.LocalPath))
+	}
+	return pathMappings + "\n    </path-mappings>"
+}
+
+func (j *jetbrainsAdaptor) initGolandRunRemoteConfig(name string, port int32, mappings []*app.SourceMapping) error {
 	configName := fmt.Sprintf("Mirage - Remote Debug %s", name)
 	runTmpl := `
 <component name="ProjectRunConfigurationManager">
   <configuration default="false" name="%s" type="GoRemoteDebugConfigurationType" factoryName="Go Remote" port="%d">
-    <option name="disconnectOption" value="STOP" />
+    <option name="disconnectOption" value="STOP" />%s
     <method v="2">
       <option name="RunConfigurationTask" enabled="true" run_configuration_name="%s" run_configuration_type="ShConfigurationType" />
     </method>
   </configuration>
 </component>
 `
-	xml := fmt.Sprintf(runTmpl, configName, port, fmt.Sprintf("%s %s", prepareScriptName, name))
+	xml := fmt.Sprintf(runTmpl, configName, port, pathMappingsXML(mappings), fmt.Sprintf("%s %s", prepareScriptName, name))
 	f := path.Join(".run", fmt.Sprintf("%s.run.xml", configName))
 	os.MkdirAll(path.Dir(f), 0755)
 	if err := os.WriteFile(f, []byte(xml), 0644); err != nil {
@@ -73,19 +131,72 @@ func (j *jetbrainsAdaptor) initGolandRunRemoteConfig(name string, port int32) er
 	return nil
 }
 
-func (j *jetbrainsAdaptor) initCLionRunRemoteConfig(name string, port int32) error {
+func (j *jetbrainsAdaptor) initCLionRunRemoteConfig(name string, port int32, mappings []*app.SourceMapping) error {
 	configName := fmt.Sprintf("Mirage - Remote Debug %s", name)
 	runTmpl := `
 <component name="ProjectRunConfigurationManager">
   <configuration default="false" name="%s" type="CLion_Remote" version="1" remoteCommand="127.0.0.1:%d" symbolFile="" sysroot="">
-    <debugger kind="GDB" isBundled="true" />
+    <debugger kind="GDB" isBundled="true" />%s
+    <method v="2">
+      <option name="RunConfigurationTask" enabled="true" run_configuration_name="%s" run_configuration_type="ShConfigurationType" />
+    </method>
+  </configuration>
+</component>
+`
+	xml := fmt.Sprintf(runTmpl, configName, port, pathMappingsXML(mappings), fmt.Sprintf("%s %s", prepareScriptName, name))
+	f := path.Join(".run", fmt.Sprintf("%s.run.xml", configName))
+	os.MkdirAll(path.Dir(f), 0755)
+	if err := os.WriteFile(f, []byte(xml), 0644); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (j *jetbrainsAdaptor) initWebStormRunRemoteConfig(name string, port int32, remoteRoot string, localRoot string) error {
+	configName := fmt.Sprintf("Mirage - Remote Debug %s", name)
+	runTmpl := `
+<component name="ProjectRunConfigurationManager">
+  <configuration default="false" name="%s" type="ChromiumRemoteDebugType" factoryName="Chromium Remote" host="127.0.0.1" port="%d">
+    <mapping url="file://%s" local-file="$PROJECT_DIR$/%s" />
+    <method v="2">
+      <option name="RunConfigurationTask" enabled="true" run_configuration_name="%s" run_configuration_type="ShConfigurationType" />
+    </method>
+  </configuration>
+</component>
+`
+	xml := fmt.Sprintf(runTmpl, configName, port, remoteRoot, localRoot, fmt.Sprintf("%s %s", prepareScriptName, name))
+	f := path.Join(".run", fmt.Sprintf("%s.run.xml", configName))
+	os.MkdirAll(path.Dir(f), 0755)
+	if err := os.WriteFile(f, []byte(xml), 0644); err != nil {
+		return err
+	}
+	return nil
+}
+
+// initIntelliJRunRemoteConfig writes the Remote JVM Debug config, it has no path mappings,
+// as JDWP locates the sources by the class names instead of the paths in the binary.
+func (j *jetbrainsAdaptor) initIntelliJRunRemoteConfig(name string, port int32) error {
+	configName := fmt.Sprintf("Mirage - Remote Debug %s", name)
+	runTmpl := `
+<component name="ProjectRunConfigurationManager">
+  <configuration default="false" name="%s" type="Remote">
+    <option name="USE_SOCKET_TRANSPORT" value="true" />
+    <option name="SERVER_MODE" value="false" />
+    <option name="SHMEM_ADDRESS" />
+    <option name="HOST" value="127.0.0.1" />
+    <option name="PORT" value="%d" />
+    <option name="AUTO_RESTART" value="false" />
+    <RunnerSettings RunnerId="Debug">
+      <option name="DEBUG_PORT" value="%d" />
+      <option name="LOCAL" value="false" />
+    </RunnerSettings>
     <method v="2">
       <option name="RunConfigurationTask" enabled="true" run_configuration_name="%s" run_configuration_type="ShConfigurationType" />
     </method>
   </configuration>
 </component>
 `
-	xml := fmt.Sprintf(runTmpl, configName, port, fmt.Sprintf("%s %s", prepareScriptName, name))
+	xml := fmt.Sprintf(runTmpl, configName, port, port, fmt.Sprintf("%s %s", prepareScriptName, name))
 	f := path.Join(".run", fmt.Sprintf("%s.run.xml", configName))
 	os.MkdirAll(path.Dir(f), 0755)
 	if err := os.WriteFile(f, []byte(xml), 0644); err != nil {
@@ -99,16 +210,38 @@ func (j *jetbrainsAdaptor) PrepareLaunch(a *app.App) error {
 	if pwd != a.LocalConfig.WorkingDir {
 		return fmt.Errorf("you are not in the project root directory(%s)", a.LocalConfig.WorkingDir)
 	}
+	if a.LocalConfig.IdeType == app.IDEType_PYCHARM {
+		// the Python Debug Server of PyCharm waits for pydevd to connect,
+		// it can not attach to the debugpy server in the pod.
+		return fmt.Errorf("PyCharm can not attach to debugpy, use VS Code for python apps")
+	}
+	if a.LocalConfig.IdeType == app.IDEType_RIDER {
+		// the remote configs of Rider speak the Mono soft-debugger protocol,
+		// netcoredbg in the pod serves the debug adapter protocol only.
+		return fmt.Errorf("Rider can not connect to netcoredbg, use VS Code for dotnet apps")
+	}
 	if err := j.initPreloadScript(a.Name); err != nil {
 		return err
 	}
+	if err := j.initPostDebugScript(a); err != nil {
+		return err
+	}
 	switch a.LocalConfig.IdeType {
 	case app.IDEType_GOLAND:
-		if err := j.initGolandRunRemoteConfig(a.Name, a.RemoteConfig.RemoteDebuggingPort); err != nil {
+		if err := j.initGolandRunRemoteConfig(a.Name, a.RemoteConfig.RemoteDebuggingPort, langadaptors.SourceMappings(a)); err != nil {
 			return err
 		}
 	case app.IDEType_CLION:
-		if err := j.initCLionRunRemoteConfig(a.Name, a.RemoteConfig.RemoteDebuggingPort); err != nil {
+		if err := j.initCLionRunRemoteConfig(a.Name, a.RemoteConfig.RemoteDebuggingPort, langadaptors.SourceMappings(a)); err != nil {
+			return err
+		}
+	case app.IDEType_WEBSTORM:
+		remoteRoot := path.Join(a.RemoteConfig.RemoteAppLocation, path.Base(a.LocalConfig.BuildOutput))
+		if err := j.initWebStormRunRemoteConfig(a.Name, a.RemoteConfig.RemoteDebuggingPort, remoteRoot, node.DistDir(a)); err != nil {
+			return err
+		}
+	case app.IDEType_INTELLIJ:
+		if err := j.initIntelliJRunRemoteConfig(a.Name, a.RemoteConfig.RemoteDebuggingPort); err != nil {
 			return err
 		}
 	default:
@@ -116,3 +249,20 @@ func (j *jetbrainsAdaptor) PrepareLaunch(a *app.App) error {
 	}
 	return nil
 }
+
+func (j *jetbrainsAdaptor) Cleanup(a *app.App) error {
+	configNames := []string{
+		fmt.Sprintf("%s %s", prepareScriptName, a.Name),
+		fmt.Sprintf("Mirage - Remote Debug %s", a.Name),
+	}
+	for _, command := range ideadapotors.PostDebugCommands {
+		configNames = append(configNames, postDebugConfigName(command, a.Name))
+	}
+	for _, configName := range configNames {
+		f := path.Join(a.LocalConfig.WorkingDir, ".run", fmt.Sprintf("%s.run.xml", configName))
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package ideadapotors
+
+import (
+	"strconv"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+// PathMapping is a source mapping with the paths quoted for the config of the IDE.
+type PathMapping struct {
+	From string
+	To   string
+}
+
+// SubstitutePath maps the local paths to the remote paths for dlv.
+func SubstitutePath(mappings []*app.SourceMapping) []PathMapping {
+	var result []PathMapping
+	for _, m := range mappings {
+		result = append(result, PathMapping{From: strconv.Quote(m.LocalPath), To: strconv.Quote(m.RemotePath)})
+	}
+	return result
+}
@@ -0,0 +1,204 @@
+package neovim
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+)
+
+const (
+	snippetFile = ".nvim/mirage.lua"
+	// exrcFile is loaded by neovim when 'exrc' is set.
+	exrcFile   = ".nvim.lua"
+	exrcSource = "dofile('" + snippetFile + "')"
+)
+
+type neovimAdaptor struct {
+}
+
+func NewNeovimAdaptor() ideadapotors.IDEAdaptor {
+	return &neovimAdaptor{}
+}
+
+func init() {
+	ideadapotors.Register(app.IDEType_NEOVIM.String(), NewNeovimAdaptor)
+}
+
+var snippetTmpl = template.Must(template.New("snippet").Parse(`do
+  local dap = require('dap')
+  -- run mirage-debug debug before connecting, like the preLaunchTask of VS Code.
+  local function before()
+    local out = vim.fn.system({ {{ .Exe }}, 'debug', {{ .Name }} })
+    if vim.v.shell_error ~= 0 then
+      error('mirage-debug debug failed: ' .. out)
+    end
+  end
+  dap.configurations.{{ .Language }} = dap.configurations.{{ .Language }} or {}
+{{- if eq .Debugger "dlv" }}
+  dap.adapters[{{ .Adapter }}] = function(callback, config)
+    before()
+    callback({ type = 'server', host = '127.0.0.1', port = {{ .Port }} })
+  end
+  table.insert(dap.configurations.go, {
+    type = {{ .Adapter }},
+    name = {{ .Label }},
+    request = 'attach',
+    mode = 'remote',
+{{- if .Mappings }}
+    substitutePath = {
+{{- range .Mappings }}
+      { from = {{ .From }}, to = {{ .To }} },
+{{- end }}
+    },
+{{- end }}
+  })
+{{- else if eq .Debugger "codelldb" }}
+  dap.adapters[{{ .Adapter }}] = function(callback, config)
+    before()
+    -- reuse the codelldb adapter configured by the user.
+    callback(dap.adapters.codelldb)
+  end
+  table.insert(dap.configurations.{{ .Language }}, {
+    type = {{ .Adapter }},
+    name = {{ .Label }},
+    request = 'launch',
+    targetCreateCommands = { 'target create ' .. {{ .Program }} },
+    processCreateCommands = { 'gdb-remote 127.0.0.1:{{ .Port }}' },
+    cwd = '${workspaceFolder}',
+  })
+{{- else }}
+  dap.adapters[{{ .Adapter }}] = function(callback, config)
+    before()
+    callback({ type = 'executable', command = {{ .GDB }}, args = { '-i', 'dap' } })
+  end
+  table.insert(dap.configurations.{{ .Language }}, {
+    type = {{ .Adapter }},
+    name = {{ .Label }},
+    request = 'attach',
+    target = '127.0.0.1:{{ .Port }}',
+    program = {{ .Program }},
+    cwd = '${workspaceFolder}',
+  })
+{{- end }}
+end
+`))
+
+func (n *neovimAdaptor) renderSnippet(a *app.App) (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	var language, debugger string
+	switch a.ProgramType {
+	case app.ProgramType_GO:
+		language, debugger = "go", "dlv"
+	case app.ProgramType_RUST, app.ProgramType_CPP:
+		language = "rust"
+		if a.ProgramType == app.ProgramType_CPP {
+			language = "cpp"
+		}
+		debugger = "gdb"
+		if a.LocalConfig.Metadata["nvimDebugger"] == "codelldb" {
+			debugger = "codelldb"
+		}
+	default:
+		return "", fmt.Errorf("neovim does not support program type %s yet", app.ProgramTypeName(a))
+	}
+	gdb := a.LocalConfig.Metadata["gdbpath"]
+	if gdb == "" {
+		gdb = "gdb"
+	}
+	program := a.LocalConfig.BuildOutput
+	if !path.IsAbs(program) {
+		program = path.Join(a.LocalConfig.WorkingDir, program)
+	}
+	buf := bytes.NewBuffer(nil)
+	err = snippetTmpl.Execute(buf, map[string]interface{}{
+		"Exe":      strconv.Quote(exe),
+		"Name":     strconv.Quote(a.Name),
+		"Adapter":  strconv.Quote("mirage-" + a.Name),
+		"Label":    strconv.Quote(fmt.Sprintf("Remote debug %s", a.Name)),
+		"Language": language,
+		"Debugger": debugger,
+		"Port":     a.RemoteConfig.RemoteDebuggingPort,
+		"Mappings": ideadapotors.SubstitutePath(langadaptors.SourceMappings(a)),
+		"Program":  strconv.Quote(program),
+		"GDB":      strconv.Quote(gdb),
+	})
+	return buf.String(), err
+}
+
+func (n *neovimAdaptor) initExrc() error {
+	content, err := os.ReadFile(exrcFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if strings.Contains(string(content), exrcSource) {
+		return nil
+	}
+	if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		content = append(content, '\n')
+	}
+	content = append(content, []byte(exrcSource+"\n")...)
+	return os.WriteFile(exrcFile, content, 0644)
+}
+
+func (n *neovimAdaptor) PrepareLaunch(a *app.App) error {
+	pwd, _ := os.Getwd()
+	if pwd != a.LocalConfig.WorkingDir {
+		return fmt.Errorf("you are not in the project root directory(%s)", a.LocalConfig.WorkingDir)
+	}
+	snippet, err := n.renderSnippet(a)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(snippetFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	os.MkdirAll(path.Dir(snippetFile), 0755)
+	if err := os.WriteFile(snippetFile, []byte(ideadapotors.ReplaceBlock(string(content), "--", a.Name, snippet)), 0644); err != nil {
+		return err
+	}
+	return n.initExrc()
+}
+
+func (n *neovimAdaptor) Cleanup(a *app.App) error {
+	f := path.Join(a.LocalConfig.WorkingDir, snippetFile)
+	content, err := os.ReadFile(f)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	rest := ideadapotors.RemoveBlock(string(content), "--", a.Name)
+	if strings.TrimSpace(rest) != "" {
+		return os.WriteFile(f, []byte(rest), 0644)
+	}
+	// the last app is removed, so is the source of the snippet.
+	if err := os.Remove(f); err != nil {
+		return err
+	}
+	exrc := path.Join(a.LocalConfig.WorkingDir, exrcFile)
+	content, err = os.ReadFile(exrc)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	rest = strings.Replace(string(content), exrcSource+"\n", "", 1)
+	if strings.TrimSpace(rest) == "" {
+		return os.Remove(exrc)
+	}
+	return os.WriteFile(exrc, []byte(rest), 0644)
+}
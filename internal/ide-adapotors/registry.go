@@ -0,0 +1,55 @@
+package ideadapotors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]func() IDEAdaptor{}
+)
+
+// Register registers the IDE adaptor of the IDE type name,
+// the later one overrides the former one.
+func Register(name string, factory func() IDEAdaptor) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[name] = factory
+}
+
+// NewIDEAdaptor returns the registered IDE adaptor of the IDE type of the app.
+func NewIDEAdaptor(a *app.App) (IDEAdaptor, error) {
+	registryLock.RLock()
+	factory, ok := registry[app.IDETypeName(a)]
+	registryLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("ide type %s not supported", app.IDETypeName(a))
+	}
+	return factory(), nil
+}
+
+// IDETypeNames returns the names of all registered IDE types, the builtin ones come first.
+func IDETypeNames() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		// plugin provided IDE types are not in the enum, whose value is 0.
+		vi, vj := app.IDEType_value[names[i]], app.IDEType_value[names[j]]
+		if vi != vj {
+			if vi == 0 || vj == 0 {
+				return vj == 0
+			}
+			return vi < vj
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
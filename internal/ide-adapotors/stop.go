@@ -0,0 +1,18 @@
+package ideadapotors
+
+import "github.com/miragedebug/miragedebug/api/app"
+
+// PostDebugCommands are the mirage-debug commands may run when the debug session ends.
+var PostDebugCommands = []string{"stop", "rollback"}
+
+// PostDebugCommand returns the mirage-debug command to run when the debug session ends,
+// empty means nothing to run.
+func PostDebugCommand(a *app.App) string {
+	switch a.GetLocalConfig().GetStopBehavior() {
+	case app.StopBehavior_STOP_DEBUGGER:
+		return "stop"
+	case app.StopBehavior_ROLLBACK:
+		return "rollback"
+	}
+	return ""
+}
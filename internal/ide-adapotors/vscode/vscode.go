@@ -11,6 +11,8 @@ import (
 
 	"github.com/miragedebug/miragedebug/api/app"
 	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/internal/lang-adaptors/node"
 )
 
 type vscodeAdaptor struct {
@@ -20,6 +22,10 @@ func NewVSCodeAdaptor() ideadapotors.IDEAdaptor {
 	return &vscodeAdaptor{}
 }
 
+func init() {
+	ideadapotors.Register(app.IDEType_VS_CODE.String(), NewVSCodeAdaptor)
+}
+
 type taskConfig struct {
 	Version string                   `json:"version"`
 	Tasks   []map[string]interface{} `json:"tasks"`
@@ -28,21 +34,87 @@ type taskConfig struct {
 type launchConfig struct {
 	Version string                   `json:"version"`
 	Configs []map[string]interface{} `json:"configurations"`
+	Inputs  []map[string]interface{} `json:"inputs,omitempty"`
 }
 
-func (j *vscodeAdaptor) initPreloadScript(name string) ([]byte, error) {
+// pidInputID returns the id of the input prompting the pid to attach.
+func pidInputID(name string) string {
+	return fmt.Sprintf("mirage-pid-%s", name)
+}
+
+func prepareTaskLabel(name string) string {
+	return fmt.Sprintf("prepare-and-build-%s", name)
+}
+
+func logsTaskLabel(name string) string {
+	return fmt.Sprintf("logs-%s", name)
+}
+
+func postDebugTaskLabel(command, name string) string {
+	return fmt.Sprintf("%s-%s", command, name)
+}
+
+// taskLabels returns the labels of all tasks may be created for the app.
+func taskLabels(name string) []string {
+	labels := []string{prepareTaskLabel(name), logsTaskLabel(name)}
+	for _, command := range ideadapotors.PostDebugCommands {
+		labels = append(labels, postDebugTaskLabel(command, name))
+	}
+	return labels
+}
+
+func (j *vscodeAdaptor) initPreloadScript(a *app.App) ([]byte, error) {
 	exe, err := os.Executable()
 	if err != nil {
 		return nil, err
 	}
+	name := a.Name
+	// the logs task streams the output of the app in a dedicated panel beside the debug console
+	// during the debug session, it keeps running and reattaches after the pod restarts.
+	logsTask := map[string]interface{}{
+		"type":         "shell",
+		"label":        logsTaskLabel(name),
+		"command":      exe,
+		"args":         []string{"logs", "-f", "--tail", "20", name},
+		"isBackground": true,
+		"presentation": map[string]interface{}{
+			"reveal": "always",
+			"panel":  "dedicated",
+		},
+		// the task is ready once the streaming starts, nothing to match in the output.
+		"problemMatcher": map[string]interface{}{
+			"owner": "mirage-debug",
+			"pattern": map[string]interface{}{
+				"regexp": "^__mirage_never_match__$",
+			},
+			"background": map[string]interface{}{
+				"activeBegin":   true,
+				"beginsPattern": "^==> streaming logs of ",
+				"endsPattern":   "^==> streaming logs of ",
+			},
+		},
+	}
 	prepareTask := map[string]interface{}{
 		"type":    "shell",
-		"label":   fmt.Sprintf("prepare-and-build-%s", name),
+		"label":   prepareTaskLabel(name),
 		"command": exe,
 		"args": []string{
 			"debug",
 			name,
 		},
+		"dependsOn": []string{logsTaskLabel(name)},
+	}
+	tasks := []map[string]interface{}{logsTask, prepareTask}
+	if command := ideadapotors.PostDebugCommand(a); command != "" {
+		tasks = append(tasks, map[string]interface{}{
+			"type":    "shell",
+			"label":   postDebugTaskLabel(command, name),
+			"command": exe,
+			"args": []string{
+				command,
+				name,
+			},
+		})
 	}
 	bs, _ := json.Marshal(prepareTask)
 	taskFile := path.Join(".vscode", "tasks.json")
@@ -50,17 +122,19 @@ func (j *vscodeAdaptor) initPreloadScript(name string) ([]byte, error) {
 	tc := taskConfig{}
 	if _, err := os.Stat(taskFile); os.IsNotExist(err) {
 		tc.Version = "2.0.0"
-		tc.Tasks = []map[string]interface{}{prepareTask}
+		tc.Tasks = tasks
 	} else {
 		j, _ := os.ReadFile(taskFile)
 		jc := jsonc.ToJSON([]byte(j))
 		if err := json.Unmarshal(jc, &tc); err != nil {
 			return bs, err
 		}
+		// the post debug task of the previous stop behavior is removed too.
 		tc.Tasks = lo.Filter(tc.Tasks, func(item map[string]interface{}, index int) bool {
-			return item["label"] != prepareTask["label"]
+			label, _ := item["label"].(string)
+			return !lo.Contains(taskLabels(name), label)
 		})
-		tc.Tasks = append(tc.Tasks, prepareTask)
+		tc.Tasks = append(tc.Tasks, tasks...)
 	}
 	tcbs, err := json.MarshalIndent(tc, "", "  ")
 	if err != nil {
@@ -69,20 +143,35 @@ func (j *vscodeAdaptor) initPreloadScript(name string) ([]byte, error) {
 	return bs, os.WriteFile(taskFile, tcbs, 0644)
 }
 
-func (j *vscodeAdaptor) initRunRemoteConfig(programType app.ProgramType, name string, port int32, buildOutput string, gdbpath string) ([]byte, error) {
+func (j *vscodeAdaptor) initRunRemoteConfig(a *app.App) ([]byte, error) {
 	var launchTask map[string]interface{}
+	var input map[string]interface{}
+	name := a.Name
+	port := a.RemoteConfig.RemoteDebuggingPort
+	buildOutput := a.LocalConfig.BuildOutput
+	gdbpath := a.LocalConfig.Metadata["gdbpath"]
+	// the build output is copied into RemoteAppLocation as a whole.
+	remoteRoot := path.Join(a.RemoteConfig.RemoteAppLocation, path.Base(buildOutput))
 	label := fmt.Sprintf("Remote debug %s", name)
-	switch programType {
+	mappings := langadaptors.SourceMappings(a)
+	switch a.ProgramType {
 	case app.ProgramType_GO:
 		launchTask = map[string]interface{}{
 			"name":          label,
 			"type":          "go",
 			"request":       "attach",
 			"mode":          "remote",
-			"remotePath":    "${workspaceFolder}",
 			"port":          port,
 			"host":          "127.0.0.1",
-			"preLaunchTask": fmt.Sprintf("prepare-and-build-%s", name),
+			"preLaunchTask": prepareTaskLabel(name),
+		}
+		if len(mappings) > 0 {
+			launchTask["substitutePath"] = lo.Map(mappings, func(m *app.SourceMapping, _ int) map[string]interface{} {
+				return map[string]interface{}{
+					"from": m.LocalPath,
+					"to":   m.RemotePath,
+				}
+			})
 		}
 	case app.ProgramType_RUST:
 		launchTask = map[string]interface{}{
@@ -99,8 +188,125 @@ func (j *vscodeAdaptor) initRunRemoteConfig(programType app.ProgramType, name st
 				}
 				return gdbpath
 			}(),
-			"preLaunchTask": fmt.Sprintf("prepare-and-build-%s", name),
+			"preLaunchTask": prepareTaskLabel(name),
+		}
+		if len(mappings) > 0 {
+			launchTask["autorun"] = lo.Map(mappings, func(m *app.SourceMapping, _ int) string {
+				return fmt.Sprintf("set substitute-path %s %s", m.RemotePath, m.LocalPath)
+			})
+		}
+	case app.ProgramType_PYTHON:
+		launchTask = map[string]interface{}{
+			"name":    label,
+			"type":    "debugpy",
+			"request": "attach",
+			"connect": map[string]interface{}{
+				"host": "127.0.0.1",
+				"port": port,
+			},
+			"pathMappings": []map[string]interface{}{
+				{
+					"localRoot":  "${workspaceFolder}",
+					"remoteRoot": remoteRoot,
+				},
+			},
+			"justMyCode":    false,
+			"preLaunchTask": prepareTaskLabel(name),
+		}
+	case app.ProgramType_NODE:
+		launchTask = map[string]interface{}{
+			"name":          label,
+			"type":          "node",
+			"request":       "attach",
+			"address":       "127.0.0.1",
+			"port":          port,
+			"localRoot":     "${workspaceFolder}/" + node.DistDir(a),
+			"remoteRoot":    remoteRoot,
+			"sourceMaps":    true,
+			"outFiles":      []string{fmt.Sprintf("${workspaceFolder}/%s/**/*.js", node.DistDir(a))},
+			"preLaunchTask": prepareTaskLabel(name),
+		}
+	case app.ProgramType_JAVA:
+		launchTask = map[string]interface{}{
+			"name":          label,
+			"type":          "java",
+			"request":       "attach",
+			"hostName":      "127.0.0.1",
+			"port":          port,
+			"preLaunchTask": prepareTaskLabel(name),
+		}
+	case app.ProgramType_CPP:
+		// "target:" fetches the shared libraries from the container by gdbserver.
+		sysroot := a.LocalConfig.Metadata["sysroot"]
+		if sysroot == "" {
+			sysroot = "target:"
+		}
+		launchTask = map[string]interface{}{
+			"name":    label,
+			"type":    "cppdbg",
+			"request": "launch",
+			"program": func() string {
+				if path.IsAbs(buildOutput) {
+					return buildOutput
+				}
+				return "${workspaceFolder}/" + buildOutput
+			}(),
+			"cwd":                     "${workspaceFolder}",
+			"MIMode":                  "gdb",
+			"miDebuggerServerAddress": fmt.Sprintf("127.0.0.1:%d", port),
+			"miDebuggerPath": func() string {
+				if gdbpath == "" {
+					return "/usr/bin/gdb"
+				}
+				return gdbpath
+			}(),
+			"setupCommands": []map[string]interface{}{
+				{
+					"text":           "-enable-pretty-printing",
+					"ignoreFailures": true,
+				},
+				{
+					"text": "set sysroot " + sysroot,
+				},
+			},
+			"preLaunchTask": prepareTaskLabel(name),
 		}
+		if len(mappings) > 0 {
+			launchTask["sourceFileMap"] = lo.SliceToMap(mappings, func(m *app.SourceMapping) (string, string) {
+				return m.RemotePath, m.LocalPath
+			})
+		}
+	case app.ProgramType_DOTNET:
+		// netcoredbg serves the debug adapter protocol on the forwarded port.
+		launchTask = map[string]interface{}{
+			"name":          label,
+			"type":          "coreclr",
+			"debugServer":   port,
+			"justMyCode":    false,
+			"sourceFileMap": map[string]string{remoteRoot: "${workspaceFolder}"},
+			"preLaunchTask": prepareTaskLabel(name),
+		}
+		if a.RemoteConfig.LaunchMode == app.LaunchMode_ATTACH {
+			// the remote netcoredbg attaches to the process in the attach request,
+			// its pid in the container is prompted.
+			launchTask["request"] = "attach"
+			launchTask["processId"] = fmt.Sprintf("${input:%s}", pidInputID(name))
+			input = map[string]interface{}{
+				"id":          pidInputID(name),
+				"type":        "promptString",
+				"description": fmt.Sprintf("The pid of %s in the container", name),
+				"default":     "1",
+			}
+		} else {
+			// the remote netcoredbg launches the app of its command line in the launch request.
+			launchTask["request"] = "launch"
+			launchTask["program"] = "dotnet"
+			launchTask["args"] = []string{path.Join(remoteRoot, a.LocalConfig.AppEntryPath)}
+			launchTask["cwd"] = remoteRoot
+		}
+	}
+	if command := ideadapotors.PostDebugCommand(a); command != "" {
+		launchTask["postDebugTask"] = postDebugTaskLabel(command, name)
 	}
 	bs, _ := json.Marshal(launchTask)
 	taskFile := path.Join(".vscode", "launch.json")
@@ -120,6 +326,12 @@ func (j *vscodeAdaptor) initRunRemoteConfig(programType app.ProgramType, name st
 		})
 		tc.Configs = append(tc.Configs, launchTask)
 	}
+	tc.Inputs = lo.Filter(tc.Inputs, func(item map[string]interface{}, index int) bool {
+		return item["id"] != pidInputID(name)
+	})
+	if input != nil {
+		tc.Inputs = append(tc.Inputs, input)
+	}
 	tcbs, err := json.MarshalIndent(tc, "", "  ")
 	if err != nil {
 		return bs, err
@@ -132,9 +344,50 @@ func (j *vscodeAdaptor) PrepareLaunch(a *app.App) error {
 	if pwd != a.LocalConfig.WorkingDir {
 		return fmt.Errorf("you are not in the project root directory(%s)", a.LocalConfig.WorkingDir)
 	}
-	if _, err := j.initPreloadScript(a.Name); err != nil {
+	if _, err := j.initPreloadScript(a); err != nil {
 		return err
 	}
-	_, err := j.initRunRemoteConfig(a.ProgramType, a.Name, a.RemoteConfig.RemoteDebuggingPort, a.LocalConfig.BuildOutput, a.LocalConfig.Metadata["gdbpath"])
+	_, err := j.initRunRemoteConfig(a)
 	return err
 }
+
+// removeEntries removes the entries whose field is one of values from the list of the json file.
+func removeEntries(file, list, field string, values ...string) error {
+	j, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	c := map[string]interface{}{}
+	if err := json.Unmarshal(jsonc.ToJSON(j), &c); err != nil {
+		return err
+	}
+	entries, ok := c[list].([]interface{})
+	if !ok {
+		return nil
+	}
+	c[list] = lo.Filter(entries, func(item interface{}, index int) bool {
+		entry, _ := item.(map[string]interface{})
+		value, _ := entry[field].(string)
+		return !lo.Contains(values, value)
+	})
+	bs, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, bs, 0644)
+}
+
+func (j *vscodeAdaptor) Cleanup(a *app.App) error {
+	taskFile := path.Join(a.LocalConfig.WorkingDir, ".vscode", "tasks.json")
+	if err := removeEntries(taskFile, "tasks", "label", taskLabels(a.Name)...); err != nil {
+		return err
+	}
+	launchFile := path.Join(a.LocalConfig.WorkingDir, ".vscode", "launch.json")
+	if err := removeEntries(launchFile, "inputs", "id", pidInputID(a.Name)); err != nil {
+		return err
+	}
+	return removeEntries(launchFile, "configurations", "name", fmt.Sprintf("Remote debug %s", a.Name))
+}
@@ -0,0 +1,133 @@
+package zed
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/samber/lo"
+	"muzzammil.xyz/jsonc"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+)
+
+type zedAdaptor struct {
+}
+
+func NewZedAdaptor() ideadapotors.IDEAdaptor {
+	return &zedAdaptor{}
+}
+
+func init() {
+	ideadapotors.Register(app.IDEType_ZED.String(), NewZedAdaptor)
+}
+
+func (z *zedAdaptor) initDebugScenario(a *app.App) (map[string]interface{}, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	port := a.RemoteConfig.RemoteDebuggingPort
+	scenario := map[string]interface{}{
+		"label": fmt.Sprintf("Remote debug %s", a.Name),
+		// zed runs the build task before starting the debug session,
+		// like the preLaunchTask of VS Code.
+		"build": map[string]interface{}{
+			"command": exe,
+			"args":    []string{"debug", a.Name},
+			"cwd":     "$ZED_WORKTREE_ROOT",
+		},
+	}
+	switch a.ProgramType {
+	case app.ProgramType_GO:
+		scenario["adapter"] = "Delve"
+		scenario["request"] = "attach"
+		scenario["mode"] = "remote"
+		scenario["tcp_connection"] = map[string]interface{}{
+			"host": "127.0.0.1",
+			"port": port,
+		}
+		if mappings := langadaptors.SourceMappings(a); len(mappings) > 0 {
+			scenario["substitutePath"] = lo.Map(mappings, func(m *app.SourceMapping, _ int) map[string]interface{} {
+				return map[string]interface{}{
+					"from": m.LocalPath,
+					"to":   m.RemotePath,
+				}
+			})
+		}
+	case app.ProgramType_RUST, app.ProgramType_CPP:
+		program := a.LocalConfig.BuildOutput
+		if !path.IsAbs(program) {
+			program = "$ZED_WORKTREE_ROOT/" + program
+		}
+		scenario["adapter"] = "GDB"
+		scenario["request"] = "attach"
+		scenario["target"] = fmt.Sprintf("127.0.0.1:%d", port)
+		scenario["program"] = program
+		scenario["cwd"] = "$ZED_WORKTREE_ROOT"
+	default:
+		return nil, fmt.Errorf("zed does not support program type %s yet", app.ProgramTypeName(a))
+	}
+	return scenario, nil
+}
+
+func (z *zedAdaptor) PrepareLaunch(a *app.App) error {
+	pwd, _ := os.Getwd()
+	if pwd != a.LocalConfig.WorkingDir {
+		return fmt.Errorf("you are not in the project root directory(%s)", a.LocalConfig.WorkingDir)
+	}
+	scenario, err := z.initDebugScenario(a)
+	if err != nil {
+		return err
+	}
+	debugFile := path.Join(".zed", "debug.json")
+	if err := os.MkdirAll(path.Dir(debugFile), 0755); err != nil {
+		return err
+	}
+	var scenarios []map[string]interface{}
+	if _, err := os.Stat(debugFile); err == nil {
+		j, _ := os.ReadFile(debugFile)
+		jc := jsonc.ToJSON(j)
+		if err := json.Unmarshal(jc, &scenarios); err != nil {
+			return err
+		}
+		scenarios = lo.Filter(scenarios, func(item map[string]interface{}, index int) bool {
+			return item["label"] != scenario["label"]
+		})
+	}
+	scenarios = append(scenarios, scenario)
+	bs, err := json.MarshalIndent(scenarios, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(debugFile, bs, 0644)
+}
+
+func (z *zedAdaptor) Cleanup(a *app.App) error {
+	debugFile := path.Join(a.LocalConfig.WorkingDir, ".zed", "debug.json")
+	j, err := os.ReadFile(debugFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var scenarios []map[string]interface{}
+	if err := json.Unmarshal(jsonc.ToJSON(j), &scenarios); err != nil {
+		return err
+	}
+	scenarios = lo.Filter(scenarios, func(item map[string]interface{}, index int) bool {
+		return item["label"] != fmt.Sprintf("Remote debug %s", a.Name)
+	})
+	if len(scenarios) == 0 {
+		return os.Remove(debugFile)
+	}
+	bs, err := json.MarshalIndent(scenarios, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(debugFile, bs, 0644)
+}
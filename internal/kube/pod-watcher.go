@@ -0,0 +1,135 @@
+package kube
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+const podWatchRetryInterval = time.Second * 3
+
+// PodWatcher watches the pods of a label selector, and reports the new target pod
+// when the target pod is gone, terminating or not running, such as after a rollout or an eviction.
+type PodWatcher struct {
+	client    kubernetes.Interface
+	namespace string
+	selector  string
+	// resolve picks the target pod from the pods of the selector, nil means none is ready.
+	resolve func(pods []corev1.Pod) *corev1.Pod
+	podName string
+	podUID  types.UID
+	cancel  context.CancelFunc
+	once    sync.Once
+}
+
+// NewPodWatcher creates a watcher of the pods of the selector, pod is the current target pod.
+func NewPodWatcher(client kubernetes.Interface, namespace, selector string, pod *corev1.Pod, resolve func(pods []corev1.Pod) *corev1.Pod) *PodWatcher {
+	return &PodWatcher{
+		client:    client,
+		namespace: namespace,
+		selector:  selector,
+		resolve:   resolve,
+		podName:   pod.Name,
+		podUID:    pod.UID,
+	}
+}
+
+// Selector returns the label selector of the watched pods.
+func (w *PodWatcher) Selector() string {
+	return w.selector
+}
+
+// Start watches the pods in background, onReplaced is called with the new target pod
+// in the watching goroutine, the events during the call are handled after it returns.
+func (w *PodWatcher) Start(onReplaced func(pod *corev1.Pod)) {
+	w.once.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		w.cancel = cancel
+		go func() {
+			for {
+				if err := w.watch(ctx, onReplaced); err != nil {
+					log.Debugf("watch pods of %s/%s failed: %v", w.namespace, w.selector, err)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(podWatchRetryInterval):
+				}
+			}
+		}()
+	})
+}
+
+// Stop stops watching, it is safe to call before Start or more than once.
+func (w *PodWatcher) Stop() {
+	w.once.Do(func() {})
+	if w.cancel != nil {
+		w.cancel()
+	}
+}
+
+// watch lists and watches the pods until the watch is closed by the server.
+func (w *PodWatcher) watch(ctx context.Context, onReplaced func(pod *corev1.Pod)) error {
+	podClient := w.client.CoreV1().Pods(w.namespace)
+	podList, err := podClient.List(ctx, metav1.ListOptions{LabelSelector: w.selector})
+	if err != nil {
+		return err
+	}
+	pods := map[string]corev1.Pod{}
+	for _, pod := range podList.Items {
+		pods[pod.Name] = pod
+	}
+	w.check(pods, onReplaced)
+	watcher, err := podClient.Watch(ctx, metav1.ListOptions{
+		LabelSelector:   w.selector,
+		ResourceVersion: podList.ResourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	for event := range watcher.ResultChan() {
+		pod, ok := event.Object.(*corev1.Pod)
+		if !ok {
+			// the resource version is expired, relist.
+			return nil
+		}
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			pods[pod.Name] = *pod
+		case watch.Deleted:
+			delete(pods, pod.Name)
+		}
+		w.check(pods, onReplaced)
+	}
+	return nil
+}
+
+func (w *PodWatcher) check(pods map[string]corev1.Pod, onReplaced func(pod *corev1.Pod)) {
+	items := make([]corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		// the debugged pod is kept while it is running, even if there are newer pods,
+		// such as the other pods of a daemonset or after scaling up.
+		if pod.UID == w.podUID && pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			return
+		}
+		items = append(items, pod)
+	}
+	target := w.resolve(items)
+	// the pod of a statefulset is replaced by the one with the same name.
+	if target == nil || target.UID == w.podUID {
+		return
+	}
+	log.Infof("pod %s/%s is replaced by %s", w.namespace, w.podName, target.Name)
+	w.podName = target.Name
+	w.podUID = target.UID
+	onReplaced(target)
+}
@@ -0,0 +1,138 @@
+package kube
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newPod(name string, uid types.UID, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       uid,
+			Labels:    map[string]string{"app": "foo"},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+// newWatchedClient returns a fake client, and a channel closed once the pods are watched.
+func newWatchedClient(pods ...*corev1.Pod) (*fake.Clientset, <-chan struct{}) {
+	objects := make([]runtime.Object, 0, len(pods))
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	client := fake.NewSimpleClientset(objects...)
+	watching := make(chan struct{})
+	once := sync.Once{}
+	client.PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := client.Tracker().Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return false, nil, err
+		}
+		once.Do(func() { close(watching) })
+		return true, w, nil
+	})
+	return client, watching
+}
+
+// newestRunning picks the newest running pod by name.
+func newestRunning(pods []corev1.Pod) *corev1.Pod {
+	var target *corev1.Pod
+	for i := range pods {
+		if pods[i].Status.Phase != corev1.PodRunning || pods[i].DeletionTimestamp != nil {
+			continue
+		}
+		if target == nil || pods[i].Name > target.Name {
+			target = &pods[i]
+		}
+	}
+	return target
+}
+
+func startWatcher(t *testing.T, client *fake.Clientset, watching <-chan struct{}, pod *corev1.Pod) (*PodWatcher, chan string) {
+	replaced := make(chan string, 10)
+	w := NewPodWatcher(client, "default", "app=foo", pod, newestRunning)
+	w.Start(func(pod *corev1.Pod) {
+		replaced <- pod.Name
+	})
+	select {
+	case <-watching:
+	case <-time.After(time.Second * 5):
+		t.Fatal("pods are not watched")
+	}
+	return w, replaced
+}
+
+func expectReplaced(t *testing.T, replaced chan string, want string) {
+	t.Helper()
+	select {
+	case name := <-replaced:
+		if name != want {
+			t.Errorf("replaced by %s, want %s", name, want)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("replacement by %s is not reported", want)
+	}
+}
+
+func expectNotReplaced(t *testing.T, replaced chan string) {
+	t.Helper()
+	select {
+	case name := <-replaced:
+		t.Fatalf("replaced by %s unexpectedly", name)
+	case <-time.After(time.Millisecond * 200):
+	}
+}
+
+func TestPodWatcherReplaced(t *testing.T) {
+	old := newPod("foo-1", "1", corev1.PodRunning)
+	client, watching := newWatchedClient(old)
+	w, replaced := startWatcher(t, client, watching, old)
+	defer w.Stop()
+
+	ctx := context.Background()
+	pods := client.CoreV1().Pods("default")
+	if err := pods.Delete(ctx, old.Name, metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pods.Create(ctx, newPod("foo-2", "2", corev1.PodPending), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectNotReplaced(t, replaced)
+	if _, err := pods.Update(ctx, newPod("foo-2", "2", corev1.PodRunning), metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectReplaced(t, replaced, "foo-2")
+}
+
+func TestPodWatcherKeepsRunningPod(t *testing.T) {
+	old := newPod("foo-1", "1", corev1.PodRunning)
+	client, watching := newWatchedClient(old)
+	w, replaced := startWatcher(t, client, watching, old)
+	defer w.Stop()
+
+	ctx := context.Background()
+	pods := client.CoreV1().Pods("default")
+	// a newer pod of the same selector, such as after scaling up.
+	if _, err := pods.Create(ctx, newPod("foo-2", "2", corev1.PodRunning), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectNotReplaced(t, replaced)
+	// the debugged pod is evicted.
+	if _, err := pods.Update(ctx, newPod("foo-1", "1", corev1.PodFailed), metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectReplaced(t, replaced, "foo-2")
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	spdy2 "k8s.io/apimachinery/pkg/util/httpstream/spdy"
@@ -28,7 +29,7 @@ type PodPortForwarder struct {
 	localPort  int32
 	remotePort int32
 	pf         *portforward.PortForwarder
-	started    bool
+	started    atomic.Bool
 }
 
 func NewPodPortForwarder(restConfig *rest.Config, namespace string, podName string, localPort, remotePort int32) *PodPortForwarder {
@@ -47,8 +48,13 @@ func (p *PodPortForwarder) PodName() string {
 	return p.podName
 }
 
+// Connected returns whether the port-forward is working.
+func (p *PodPortForwarder) Connected() bool {
+	return p.started.Load()
+}
+
 func (p *PodPortForwarder) Start() error {
-	if p.started {
+	if p.started.Load() {
 		return nil
 	}
 	go func() {
@@ -70,7 +76,7 @@ func (p *PodPortForwarder) Start() error {
 					continue
 				}
 				p.pf = pf
-				p.started = true
+				p.started.Store(true)
 				log.Debugf("forward port %d to %s/%s port %d starting", p.localPort, p.namespace, p.podName, p.remotePort)
 				result := make(chan error)
 				go func() {
@@ -82,12 +88,12 @@ func (p *PodPortForwarder) Start() error {
 					if err != nil {
 						fmt.Printf("failed to forward port: %v\n", err)
 					}
-					p.started = false
+					p.started.Store(false)
 					<-time.After(time.Second * 3)
 					continue
 				case <-p.stopCh:
 					close(stop)
-					p.started = false
+					p.started.Store(false)
 					return
 				}
 			}
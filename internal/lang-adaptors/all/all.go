@@ -0,0 +1,12 @@
+// Package all registers all the builtin language adaptors.
+package all
+
+import (
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/cpp"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/dotnet"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/golang"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/java"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/node"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/python"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/rust"
+)
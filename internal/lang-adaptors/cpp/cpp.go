@@ -0,0 +1,162 @@
+package cpp
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/internal/local/debug-tools/gdb"
+)
+
+type cpp struct{}
+
+func NewCppAdaptor() langadaptors.LanguageAdaptor {
+	return &cpp{}
+}
+
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_CPP,
+		New:         NewCppAdaptor,
+		// the app is built by cmake, make or bazel detected by the adaptor.
+		DefaultBuildCommand: func(a *app.App) string {
+			return ""
+		},
+		DefaultBuildOutput: DefaultBuildOutput,
+		Archs:              []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+		DebugTool:          "gdbserver",
+		EntryPrompt:        "Your bazel target(eg. //src:app), empty for cmake or make projects: ",
+	})
+}
+
+var (
+	outputRe     = regexp.MustCompile(`(?:^|\s)-o\s*(\S+)`)
+	executableRe = regexp.MustCompile(`add_executable\s*\(\s*([^\s)]+)`)
+)
+
+// DefaultBuildOutput derives the build output from the build command,
+// it is the -o target of the compiler, the executable of cmake or the bazel-bin of the target,
+// empty if it can not be derived, such as the make projects.
+func DefaultBuildOutput(a *app.App) string {
+	cmd, err := (&cpp{}).BuildCommand(a)
+	if err != nil {
+		return ""
+	}
+	if m := outputRe.FindStringSubmatch(cmd); m != nil {
+		return m[1]
+	}
+	switch {
+	case strings.Contains(cmd, "bazel build"):
+		return bazelOutput(a.LocalConfig.AppEntryPath)
+	case strings.Contains(cmd, "cmake --build"):
+		content, err := os.ReadFile(path.Join(a.LocalConfig.WorkingDir, "CMakeLists.txt"))
+		if err != nil {
+			return ""
+		}
+		if m := executableRe.FindSubmatch(content); m != nil {
+			return path.Join("build", string(m[1]))
+		}
+	}
+	return ""
+}
+
+// bazelOutput returns the output of the bazel target in bazel-bin, such as //src:app to bazel-bin/src/app.
+func bazelOutput(target string) string {
+	target = strings.TrimPrefix(target, "//")
+	if target == "" {
+		return ""
+	}
+	pkg, name, ok := strings.Cut(target, ":")
+	if !ok {
+		name = path.Base(pkg)
+	}
+	return path.Join("bazel-bin", pkg, name)
+}
+
+// ToolchainPrefix returns the cross-compile toolchain prefix of the target arch,
+// such as aarch64-linux-gnu-, it can be overridden by the toolchainPrefix metadata.
+func ToolchainPrefix(a *app.App) string {
+	if p, ok := a.LocalConfig.Metadata["toolchainPrefix"]; ok {
+		return p
+	}
+	switch a.RemoteRuntime.TargetArch {
+	case app.ArchType_AMD64:
+		return "x86_64-linux-gnu-"
+	case app.ArchType_ARM64:
+		return "aarch64-linux-gnu-"
+	default:
+		return ""
+	}
+}
+
+func exists(workingDir string, files ...string) bool {
+	for _, f := range files {
+		if _, err := os.Stat(path.Join(workingDir, f)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *cpp) BuildCommand(a *app.App) (string, error) {
+	if a.ProgramType != app.ProgramType_CPP {
+		return "", fmt.Errorf("program type is not cpp")
+	}
+	if a.LocalConfig.CustomBuildCommand != "" {
+		return a.LocalConfig.CustomBuildCommand, nil
+	}
+	prefix := ToolchainPrefix(a)
+	switch {
+	case exists(a.LocalConfig.WorkingDir, "CMakeLists.txt"):
+		return fmt.Sprintf("cmake -B build -DCMAKE_BUILD_TYPE=Debug -DCMAKE_C_COMPILER=%sgcc -DCMAKE_CXX_COMPILER=%sg++ && cmake --build build",
+			prefix, prefix), nil
+	case exists(a.LocalConfig.WorkingDir, "Makefile", "makefile"):
+		return fmt.Sprintf("make CC=%sgcc CXX=%sg++", prefix, prefix), nil
+	case exists(a.LocalConfig.WorkingDir, "WORKSPACE", "WORKSPACE.bazel", "MODULE.bazel"):
+		cpu := func() string {
+			switch a.RemoteRuntime.TargetArch {
+			case app.ArchType_ARM64:
+				return "aarch64"
+			default:
+				return "k8"
+			}
+		}()
+		return fmt.Sprintf("bazel build -c dbg --cpu=%s %s", cpu, a.LocalConfig.AppEntryPath), nil
+	}
+	return "", fmt.Errorf("neither cmake, make nor bazel project found in %s", a.LocalConfig.WorkingDir)
+}
+
+func (c *cpp) DebugCommand(app_ *app.App) (string, error) {
+	if app_.ProgramType != app.ProgramType_CPP {
+		return "", fmt.Errorf("program type is not cpp")
+	}
+	return fmt.Sprintf("%s *:%d %s %s",
+		app_.RemoteConfig.DebugToolPath,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		path.Join(app_.RemoteConfig.RemoteAppLocation, path.Base(app_.LocalConfig.BuildOutput)),
+		app_.LocalConfig.AppArgs,
+	), nil
+}
+
+func (c *cpp) AttachCommand(app_ *app.App, pid int) (string, error) {
+	if app_.ProgramType != app.ProgramType_CPP {
+		return "", fmt.Errorf("program type is not cpp")
+	}
+	return fmt.Sprintf("%s --attach *:%d %d",
+		app_.RemoteConfig.DebugToolPath,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		pid,
+	), nil
+}
+
+func (c *cpp) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	return langadaptors.ProbeGDBServer(addr)
+}
+
+func (c *cpp) LocalDebugToolInstall(a *app.App) (string, error) {
+	return gdb.InstallingGDBServer(a.RemoteRuntime.TargetArch, a.LocalConfig.DebugToolBuilder.BuildCommands)
+}
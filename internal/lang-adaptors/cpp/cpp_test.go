@@ -0,0 +1,69 @@
+package cpp
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+func TestDefaultBuildOutput(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		buildCommand string
+		entry        string
+		want         string
+	}{
+		{
+			name:         "compiler output",
+			buildCommand: "aarch64-linux-gnu-g++ -g -O0 -o out/app main.cpp",
+			want:         "out/app",
+		},
+		{
+			name:  "cmake executable",
+			files: map[string]string{"CMakeLists.txt": "project(demo)\nadd_executable(server main.cpp)\n"},
+			want:  "build/server",
+		},
+		{
+			name:  "bazel target",
+			files: map[string]string{"MODULE.bazel": ""},
+			entry: "//src/server:app",
+			want:  "bazel-bin/src/server/app",
+		},
+		{
+			name:  "bazel package",
+			files: map[string]string{"WORKSPACE": ""},
+			entry: "//src/server",
+			want:  "bazel-bin/src/server/server",
+		},
+		{
+			name:  "make",
+			files: map[string]string{"Makefile": ""},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for f, content := range tt.files {
+				if err := os.WriteFile(path.Join(dir, f), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			a := &app.App{
+				ProgramType:   app.ProgramType_CPP,
+				RemoteRuntime: &app.RemoteRuntime{TargetArch: app.ArchType_AMD64},
+				LocalConfig: &app.LocalConfig{
+					WorkingDir:         dir,
+					AppEntryPath:       tt.entry,
+					CustomBuildCommand: tt.buildCommand,
+				},
+			}
+			if got := DefaultBuildOutput(a); got != tt.want {
+				t.Errorf("DefaultBuildOutput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package dotnet
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/internal/local/debug-tools/netcoredbg"
+)
+
+type dotnet struct{}
+
+func NewDotnetAdaptor() langadaptors.LanguageAdaptor {
+	return &dotnet{}
+}
+
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_DOTNET,
+		New:         NewDotnetAdaptor,
+		// the app is published for the target arch by the adaptor.
+		DefaultBuildCommand: func(a *app.App) string {
+			return ""
+		},
+		DefaultBuildOutput: func(a *app.App) string {
+			return "/tmp/" + a.Name
+		},
+		Archs:       []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+		DebugTool:   "netcoredbg",
+		EntryPrompt: "Your app assembly(eg. App.dll): ",
+	})
+}
+
+func runtimeIdentifier(arch app.ArchType) string {
+	switch arch {
+	case app.ArchType_ARM64:
+		return "linux-arm64"
+	default:
+		return "linux-x64"
+	}
+}
+
+// launcher returns the commands to extract netcoredbg installed at DebugToolPath as a tarball,
+// and the extracted netcoredbg.
+func launcher(app_ *app.App) (string, string) {
+	dir := app_.RemoteConfig.DebugToolPath + ".d"
+	prepare := fmt.Sprintf("rm -rf %s && mkdir -p %s && tar -xzf %s -C %s",
+		dir, dir,
+		app_.RemoteConfig.DebugToolPath, dir,
+	)
+	return prepare, path.Join(dir, "netcoredbg", "netcoredbg")
+}
+
+// BuildCommand publishes the app with the Debug configuration to BuildOutput,
+// which is copied into the container as a whole.
+func (d *dotnet) BuildCommand(a *app.App) (string, error) {
+	if a.ProgramType != app.ProgramType_DOTNET {
+		return "", fmt.Errorf("program type is not dotnet")
+	}
+	if a.LocalConfig.CustomBuildCommand != "" {
+		return a.LocalConfig.CustomBuildCommand, nil
+	}
+	return fmt.Sprintf("dotnet publish -c Debug -r %s --self-contained false -o %s %s",
+		runtimeIdentifier(a.RemoteRuntime.TargetArch), a.LocalConfig.BuildOutput, a.LocalConfig.Metadata["project"]), nil
+}
+
+// DebugCommand serves the debug adapter protocol, the app is launched by netcoredbg
+// when the IDE connects.
+func (d *dotnet) DebugCommand(app_ *app.App) (string, error) {
+	if app_.ProgramType != app.ProgramType_DOTNET {
+		return "", fmt.Errorf("program type is not dotnet")
+	}
+	prepare, debugger := launcher(app_)
+	remoteRoot := path.Join(app_.RemoteConfig.RemoteAppLocation, path.Base(app_.LocalConfig.BuildOutput))
+	return fmt.Sprintf("%s && cd %s && %s --server=%d --interpreter=vscode -- dotnet %s %s",
+		prepare,
+		remoteRoot,
+		debugger,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		path.Join(remoteRoot, app_.LocalConfig.AppEntryPath),
+		app_.LocalConfig.AppArgs,
+	), nil
+}
+
+// AttachCommand serves the debug adapter protocol, netcoredbg attaches to the process
+// in the attach request of the IDE.
+func (d *dotnet) AttachCommand(app_ *app.App, pid int) (string, error) {
+	if app_.ProgramType != app.ProgramType_DOTNET {
+		return "", fmt.Errorf("program type is not dotnet")
+	}
+	prepare, debugger := launcher(app_)
+	return fmt.Sprintf("%s && %s --server=%d --interpreter=vscode",
+		prepare,
+		debugger,
+		app_.RemoteConfig.RemoteDebuggingPort,
+	), nil
+}
+
+func (d *dotnet) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	// netcoredbg serves a single client session, connecting to it would be taken as the IDE,
+	// so the listening socket is checked in the pod instead.
+	return langadaptors.ProbeListening(executor, app_.RemoteConfig.RemoteDebuggingPort)
+}
+
+func (d *dotnet) LocalDebugToolInstall(a *app.App) (string, error) {
+	return netcoredbg.InitOrLoadNetcoredbg(a.RemoteRuntime.TargetArch, a.LocalConfig.DebugToolBuilder.BuildCommands)
+}
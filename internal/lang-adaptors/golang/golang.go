@@ -2,7 +2,10 @@ package golang
 
 import (
 	"fmt"
+	"io/fs"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/miragedebug/miragedebug/api/app"
 	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
@@ -15,6 +18,44 @@ func NewGolangAdaptor() langadaptors.LanguageAdaptor {
 	return &golang{}
 }
 
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_GO,
+		New:         NewGolangAdaptor,
+		DefaultBuildCommand: func(a *app.App) string {
+			entry := a.LocalConfig.AppEntryPath
+			if entry == "" {
+				entry = "./"
+			}
+			return fmt.Sprintf("GOOS=linux GOARCH=%s go build -o /tmp/%s %s", app.ToSystemArch(a.RemoteRuntime.TargetArch), a.Name, entry)
+		},
+		DefaultBuildOutput: func(a *app.App) string {
+			return "/tmp/" + a.Name
+		},
+		Archs:        []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+		DebugTool:    "dlv",
+		EntryPrompt:  "Your app entry path: ",
+		EntryOptions: mainPackages,
+	})
+}
+
+// mainPackages returns the packages of main.go in the working dir, and the empty entry.
+func mainPackages(a *app.App) []string {
+	workdir := a.LocalConfig.WorkingDir
+	paths := []string{""}
+	filepath.Walk(workdir, func(p string, info fs.FileInfo, err error) error {
+		if info.IsDir() {
+			return nil
+		}
+		p = strings.TrimPrefix(p, workdir)
+		if info.Name() == "main.go" {
+			paths = append(paths, "."+path.Dir(p))
+		}
+		return nil
+	})
+	return paths
+}
+
 func (g *golang) BuildCommand(a *app.App) (string, error) {
 	if a.ProgramType != app.ProgramType_GO {
 		return "", fmt.Errorf("program type is not go")
@@ -37,6 +78,21 @@ func (g *golang) DebugCommand(app_ *app.App) (string, error) {
 	), nil
 }
 
+func (g *golang) AttachCommand(app_ *app.App, pid int) (string, error) {
+	if app_.ProgramType != app.ProgramType_GO {
+		return "", fmt.Errorf("program type is not go")
+	}
+	return fmt.Sprintf("%s --listen=:%d --headless=true --api-version=2 --accept-multiclient --check-go-version=false attach %d",
+		app_.RemoteConfig.DebugToolPath,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		pid,
+	), nil
+}
+
+func (g *golang) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	return langadaptors.ProbeDlv(addr)
+}
+
 func (g *golang) LocalDebugToolInstall(a *app.App) (string, error) {
 	return debugtools.InitOrLoadDLV(a.RemoteRuntime.TargetArch, a.LocalConfig.DebugToolBuilder.BuildCommands)
 }
@@ -2,10 +2,17 @@ package langadaptors
 
 import "github.com/miragedebug/miragedebug/api/app"
 
+// RemotePodShellExecutor runs the shell commands in the debugger container of the pod,
+// and returns the stdout and stderr.
 type RemotePodShellExecutor func(commands []string) ([]byte, []byte, error)
 
 type LanguageAdaptor interface {
 	BuildCommand(a *app.App) (string, error)
 	LocalDebugToolInstall(a *app.App) (string, error)
 	DebugCommand(app_ *app.App) (string, error)
+	// AttachCommand returns the command to attach the debugger to the running process.
+	AttachCommand(app_ *app.App, pid int) (string, error)
+	// ReadinessProbe checks whether the debugger started by DebugCommand or AttachCommand
+	// accepts connections on the local forwarded address, or listens in the pod by executor.
+	ReadinessProbe(app_ *app.App, addr string, executor RemotePodShellExecutor) error
 }
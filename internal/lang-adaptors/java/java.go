@@ -0,0 +1,103 @@
+package java
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+)
+
+const defaultJava = "java"
+
+type java struct{}
+
+func NewJavaAdaptor() langadaptors.LanguageAdaptor {
+	return &java{}
+}
+
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_JAVA,
+		New:         NewJavaAdaptor,
+		// the jar is built by gradle or maven detected by the adaptor.
+		DefaultBuildCommand: func(a *app.App) string {
+			return ""
+		},
+		DefaultBuildOutput: func(a *app.App) string {
+			return "/tmp/" + a.Name + ".jar"
+		},
+		Archs: []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+	})
+}
+
+func javaBinary(app_ *app.App) string {
+	if j := app_.LocalConfig.Metadata["java"]; j != "" {
+		return j
+	}
+	return defaultJava
+}
+
+func exists(workingDir string, files ...string) bool {
+	for _, f := range files {
+		if _, err := os.Stat(path.Join(workingDir, f)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildCommand builds the jar by Gradle or Maven, and copies it to BuildOutput.
+func (j *java) BuildCommand(a *app.App) (string, error) {
+	if a.ProgramType != app.ProgramType_JAVA {
+		return "", fmt.Errorf("program type is not java")
+	}
+	if a.LocalConfig.CustomBuildCommand != "" {
+		return a.LocalConfig.CustomBuildCommand, nil
+	}
+	switch {
+	case exists(a.LocalConfig.WorkingDir, "build.gradle", "build.gradle.kts"):
+		gradle := "gradle"
+		if exists(a.LocalConfig.WorkingDir, "gradlew") {
+			gradle = "./gradlew"
+		}
+		// the plain jar of spring boot is not runnable.
+		return fmt.Sprintf("%s assemble -x test && cp $(ls build/libs/*.jar | grep -v -- '-plain.jar' | head -n 1) %s",
+			gradle, a.LocalConfig.BuildOutput), nil
+	case exists(a.LocalConfig.WorkingDir, "pom.xml"):
+		mvn := "mvn"
+		if exists(a.LocalConfig.WorkingDir, "mvnw") {
+			mvn = "./mvnw"
+		}
+		return fmt.Sprintf("%s -DskipTests package && cp $(ls target/*.jar | grep -v -e '-sources.jar' -e '-javadoc.jar' | head -n 1) %s",
+			mvn, a.LocalConfig.BuildOutput), nil
+	}
+	return "", fmt.Errorf("neither gradle nor maven project found in %s", a.LocalConfig.WorkingDir)
+}
+
+// DebugCommand runs the jar with the JDWP agent.
+func (j *java) DebugCommand(app_ *app.App) (string, error) {
+	if app_.ProgramType != app.ProgramType_JAVA {
+		return "", fmt.Errorf("program type is not java")
+	}
+	return fmt.Sprintf("%s -agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:%d -jar %s %s",
+		javaBinary(app_),
+		app_.RemoteConfig.RemoteDebuggingPort,
+		path.Join(app_.RemoteConfig.RemoteAppLocation, path.Base(app_.LocalConfig.BuildOutput)),
+		app_.LocalConfig.AppArgs,
+	), nil
+}
+
+func (j *java) AttachCommand(app_ *app.App, pid int) (string, error) {
+	return "", fmt.Errorf("the JDWP agent can not be loaded into the running jvm, use the EXEC launch mode")
+}
+
+func (j *java) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	return langadaptors.ProbeJDWP(addr)
+}
+
+// LocalDebugToolInstall installs nothing, the JDWP agent is built in the jvm.
+func (j *java) LocalDebugToolInstall(a *app.App) (string, error) {
+	return "", nil
+}
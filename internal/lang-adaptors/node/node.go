@@ -0,0 +1,105 @@
+package node
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+)
+
+const (
+	defaultNode    = "node"
+	defaultDistDir = "dist"
+	// the inspector activated by SIGUSR1 always listens on the default port.
+	defaultInspectorPort = 9229
+)
+
+type node struct{}
+
+func NewNodeAdaptor() langadaptors.LanguageAdaptor {
+	return &node{}
+}
+
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_NODE,
+		New:         NewNodeAdaptor,
+		// transpile and sync the dist directory to the build output.
+		DefaultBuildCommand: func(a *app.App) string {
+			return fmt.Sprintf("npx tsc && rm -rf /tmp/%s && mkdir -p /tmp/%s && cp -R %s/. /tmp/%s", a.Name, a.Name, DistDir(a), a.Name)
+		},
+		DefaultBuildOutput: func(a *app.App) string {
+			return "/tmp/" + a.Name
+		},
+		Archs: []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+		// kill -USR1 exits once the inspector is activated.
+		OneShotAttach: true,
+		EntryPrompt:   "Your app entry script, relative to the dist directory: ",
+		DefaultEntry: func(a *app.App) string {
+			return "index.js"
+		},
+	})
+}
+
+func nodeBinary(app_ *app.App) string {
+	if n := app_.LocalConfig.Metadata["node"]; n != "" {
+		return n
+	}
+	return defaultNode
+}
+
+// DistDir returns the directory of the transpiled javascript, relative to the working dir.
+func DistDir(app_ *app.App) string {
+	if d := app_.LocalConfig.Metadata["distDir"]; d != "" {
+		return d
+	}
+	return defaultDistDir
+}
+
+// BuildCommand syncs the transpiled DistDir to BuildOutput, which is copied into the container as a whole.
+// Set a custom build command such as "npx tsc && ..." to transpile before syncing.
+func (n *node) BuildCommand(a *app.App) (string, error) {
+	if a.ProgramType != app.ProgramType_NODE {
+		return "", fmt.Errorf("program type is not node")
+	}
+	if a.LocalConfig.CustomBuildCommand != "" {
+		return a.LocalConfig.CustomBuildCommand, nil
+	}
+	return fmt.Sprintf("rm -rf %s && mkdir -p %s && cp -R %s/. %s",
+		a.LocalConfig.BuildOutput, a.LocalConfig.BuildOutput, DistDir(a), a.LocalConfig.BuildOutput), nil
+}
+
+// DebugCommand runs the entrypoint in the working dir of the container, so the node_modules
+// installed in the image are still resolved.
+func (n *node) DebugCommand(app_ *app.App) (string, error) {
+	if app_.ProgramType != app.ProgramType_NODE {
+		return "", fmt.Errorf("program type is not node")
+	}
+	return fmt.Sprintf("NODE_PATH=$PWD/node_modules:$NODE_PATH %s --inspect=0.0.0.0:%d %s %s",
+		nodeBinary(app_),
+		app_.RemoteConfig.RemoteDebuggingPort,
+		path.Join(app_.RemoteConfig.RemoteAppLocation, path.Base(app_.LocalConfig.BuildOutput), app_.LocalConfig.AppEntryPath),
+		app_.LocalConfig.AppArgs,
+	), nil
+}
+
+// AttachCommand activates the inspector of the running process by SIGUSR1.
+func (n *node) AttachCommand(app_ *app.App, pid int) (string, error) {
+	if app_.ProgramType != app.ProgramType_NODE {
+		return "", fmt.Errorf("program type is not node")
+	}
+	if app_.RemoteConfig.RemoteDebuggingPort != defaultInspectorPort {
+		return "", fmt.Errorf("the inspector of the running process listens on %d, set remoteDebuggingPort to %d to attach", defaultInspectorPort, defaultInspectorPort)
+	}
+	return fmt.Sprintf("kill -USR1 %d", pid), nil
+}
+
+func (n *node) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	return langadaptors.ProbeNodeInspector(addr)
+}
+
+// LocalDebugToolInstall installs nothing, the inspector is built in node.
+func (n *node) LocalDebugToolInstall(a *app.App) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,138 @@
+package langadaptors
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/rpc/jsonrpc"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProbeTimeout is the timeout of a single readiness probe.
+const ProbeTimeout = time.Second * 2
+
+// ProbeDlv calls RPCServer.GetVersion of the headless dlv.
+func ProbeDlv(addr string) error {
+	conn, err := net.DialTimeout("tcp", addr, ProbeTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(ProbeTimeout))
+	client := jsonrpc.NewClient(conn)
+	var version map[string]interface{}
+	if err := client.Call("RPCServer.GetVersion", struct{}{}, &version); err != nil {
+		return fmt.Errorf("get dlv version failed: %v", err)
+	}
+	return nil
+}
+
+// gdbPacket encodes the remote serial protocol packet.
+func gdbPacket(data string) string {
+	var sum byte
+	for i := 0; i < len(data); i++ {
+		sum += data[i]
+	}
+	return fmt.Sprintf("$%s#%02x", data, sum)
+}
+
+// ProbeGDBServer does the qSupported handshake of the remote serial protocol with gdbserver.
+// gdbserver keeps listening after the connection is closed.
+func ProbeGDBServer(addr string) error {
+	conn, err := net.DialTimeout("tcp", addr, ProbeTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(ProbeTimeout))
+	if _, err := io.WriteString(conn, gdbPacket("qSupported")); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(conn)
+	// the reply may be preceded by the acknowledgment "+".
+	reply, err := reader.ReadString('#')
+	if err != nil {
+		return fmt.Errorf("read qSupported reply failed: %v", err)
+	}
+	reply = strings.TrimLeft(reply, "+")
+	if !strings.HasPrefix(reply, "$") {
+		return fmt.Errorf("unexpected qSupported reply %q", reply)
+	}
+	// acknowledge the reply, the checksum is not needed.
+	io.WriteString(conn, "+")
+	return nil
+}
+
+// ProbeNodeInspector gets the version of the node inspector by http.
+func ProbeNodeInspector(addr string) error {
+	client := http.Client{Timeout: ProbeTimeout}
+	resp, err := client.Get(fmt.Sprintf("http://%s/json/version", addr))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get node inspector version failed: %s", resp.Status)
+	}
+	return nil
+}
+
+// ProbeJDWP does the JDWP handshake, the agent keeps listening after the connection is closed.
+func ProbeJDWP(addr string) error {
+	const handshake = "JDWP-Handshake"
+	conn, err := net.DialTimeout("tcp", addr, ProbeTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(ProbeTimeout))
+	if _, err := io.WriteString(conn, handshake); err != nil {
+		return err
+	}
+	reply := make([]byte, len(handshake))
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return fmt.Errorf("read JDWP handshake failed: %v", err)
+	}
+	if string(reply) != handshake {
+		return fmt.Errorf("unexpected JDWP handshake %q", reply)
+	}
+	return nil
+}
+
+// tcpListen is the LISTEN state in /proc/net/tcp.
+const tcpListen = "0A"
+
+// ProbeListening checks the port is listened in the pod by /proc/net/tcp, without connecting to it.
+func ProbeListening(executor RemotePodShellExecutor, port int32) error {
+	stdout, stderr, err := executor([]string{"cat /proc/net/tcp /proc/net/tcp6 2>/dev/null"})
+	if err != nil && len(stdout) == 0 {
+		return fmt.Errorf("read /proc/net/tcp failed: %v, %s", err, strings.TrimSpace(string(stderr)))
+	}
+	if !listening(string(stdout), port) {
+		return fmt.Errorf("port %d is not listened", port)
+	}
+	return nil
+}
+
+// listening returns whether the port is in LISTEN state in the content of /proc/net/tcp.
+func listening(content string, port int32) bool {
+	for _, line := range strings.Split(content, "\n") {
+		// sl local_address rem_address st ...
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[3] != tcpListen {
+			continue
+		}
+		_, hexPort, ok := strings.Cut(fields[1], ":")
+		if !ok {
+			continue
+		}
+		if p, err := strconv.ParseInt(hexPort, 16, 32); err == nil && int32(p) == port {
+			return true
+		}
+	}
+	return false
+}
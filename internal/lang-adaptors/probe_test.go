@@ -0,0 +1,84 @@
+package langadaptors
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"testing"
+)
+
+func TestGDBPacket(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{data: "qSupported", want: "$qSupported#37"},
+		{data: "", want: "$#00"},
+		{data: "OK", want: "$OK#9a"},
+	}
+	for _, tt := range tests {
+		if got := gdbPacket(tt.data); got != tt.want {
+			t.Errorf("gdbPacket(%q) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestProbeGDBServer(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		wantErr bool
+	}{
+		{name: "ack and reply", reply: "+$PacketSize=3fff;qXfer:features:read+#c6"},
+		{name: "reply without ack", reply: "$PacketSize=3fff#00"},
+		{name: "not gdbserver", reply: "HTTP/1.1 400 Bad Request\r\n\r\n", wantErr: true},
+		{name: "closed", reply: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+			go func() {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				if _, err := bufio.NewReader(conn).ReadString('#'); err != nil {
+					return
+				}
+				io.WriteString(conn, tt.reply)
+			}()
+			if err := ProbeGDBServer(l.Addr().String()); (err != nil) != tt.wantErr {
+				t.Errorf("ProbeGDBServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestListening(t *testing.T) {
+	content := `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:C350 0100007F:9C40 01 00000000:00000000 00:00000000 00000000     0        0 2 1 0000000000000000 20 4 30 10 -1
+  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:1388 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 3 1 0000000000000000 100 0 0 10 0
+`
+	tests := []struct {
+		port int32
+		want bool
+	}{
+		{port: 8080, want: true},
+		{port: 5000, want: true},
+		// established instead of listening.
+		{port: 50000, want: false},
+		{port: 5678, want: false},
+	}
+	for _, tt := range tests {
+		if got := listening(content, tt.port); got != tt.want {
+			t.Errorf("listening(%d) = %v, want %v", tt.port, got, tt.want)
+		}
+	}
+}
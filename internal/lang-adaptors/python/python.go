@@ -0,0 +1,106 @@
+package python
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/internal/local/debug-tools/debugpy"
+)
+
+const defaultInterpreter = "python3"
+
+type python struct{}
+
+func NewPythonAdaptor() langadaptors.LanguageAdaptor {
+	return &python{}
+}
+
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_PYTHON,
+		New:         NewPythonAdaptor,
+		// the sources are synced to the build output by the adaptor.
+		DefaultBuildCommand: func(a *app.App) string {
+			return ""
+		},
+		DefaultBuildOutput: func(a *app.App) string {
+			return "/tmp/" + a.Name
+		},
+		Archs:       []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+		DebugTool:   "debugpy",
+		EntryPrompt: "Your app entry script(eg. main.py or -m app): ",
+		// debugpy --pid exits once debugpy is injected into the process.
+		OneShotAttach: true,
+	})
+}
+
+func interpreter(app_ *app.App) string {
+	if p := app_.LocalConfig.Metadata["python"]; p != "" {
+		return p
+	}
+	return defaultInterpreter
+}
+
+// launcher returns the commands to prepare the debugpy installed at DebugToolPath as a wheel.
+func launcher(app_ *app.App) (string, string) {
+	libDir := app_.RemoteConfig.DebugToolPath + ".d"
+	prepare := fmt.Sprintf("%s -m zipfile -e %s %s",
+		interpreter(app_),
+		app_.RemoteConfig.DebugToolPath,
+		libDir,
+	)
+	return prepare, fmt.Sprintf("PYTHONPATH=%s %s -m debugpy", libDir, interpreter(app_))
+}
+
+// BuildCommand syncs the sources to BuildOutput, which is copied into the container as a whole.
+func (p *python) BuildCommand(a *app.App) (string, error) {
+	if a.ProgramType != app.ProgramType_PYTHON {
+		return "", fmt.Errorf("program type is not python")
+	}
+	if a.LocalConfig.CustomBuildCommand != "" {
+		return a.LocalConfig.CustomBuildCommand, nil
+	}
+	return fmt.Sprintf("rm -rf %s && mkdir -p %s && tar --exclude=.git --exclude=__pycache__ -cf - . | tar -xf - -C %s",
+		a.LocalConfig.BuildOutput, a.LocalConfig.BuildOutput, a.LocalConfig.BuildOutput), nil
+}
+
+func (p *python) DebugCommand(app_ *app.App) (string, error) {
+	if app_.ProgramType != app.ProgramType_PYTHON {
+		return "", fmt.Errorf("program type is not python")
+	}
+	prepare, debugpy := launcher(app_)
+	return fmt.Sprintf("%s && cd %s && %s --listen 0.0.0.0:%d --wait-for-client %s %s",
+		prepare,
+		path.Join(app_.RemoteConfig.RemoteAppLocation, path.Base(app_.LocalConfig.BuildOutput)),
+		debugpy,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		app_.LocalConfig.AppEntryPath,
+		app_.LocalConfig.AppArgs,
+	), nil
+}
+
+// AttachCommand injects debugpy into the running process, which requires gdb in the container.
+func (p *python) AttachCommand(app_ *app.App, pid int) (string, error) {
+	if app_.ProgramType != app.ProgramType_PYTHON {
+		return "", fmt.Errorf("program type is not python")
+	}
+	prepare, debugpy := launcher(app_)
+	return fmt.Sprintf("%s && %s --listen 0.0.0.0:%d --pid %d",
+		prepare,
+		debugpy,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		pid,
+	), nil
+}
+
+func (p *python) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	// debugpy serves a single client session, connecting to it would be taken as the IDE,
+	// so the listening socket is checked in the pod instead.
+	return langadaptors.ProbeListening(executor, app_.RemoteConfig.RemoteDebuggingPort)
+}
+
+func (p *python) LocalDebugToolInstall(a *app.App) (string, error) {
+	return debugpy.InitOrLoadDebugpy(a.LocalConfig.DebugToolBuilder.BuildCommands)
+}
@@ -0,0 +1,105 @@
+package langadaptors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+// Metadata describes a language adaptor registered for a program type.
+type Metadata struct {
+	ProgramType app.ProgramType
+	// Name is the name of the program type provided by a plugin,
+	// empty means the name of ProgramType.
+	Name string
+	// New creates the language adaptor.
+	New func() LanguageAdaptor
+	// DefaultBuildCommand returns the build command suggested to the app,
+	// empty means the adaptor builds the app by itself.
+	DefaultBuildCommand func(a *app.App) string
+	// DefaultBuildOutput returns the build output suggested to the app.
+	DefaultBuildOutput func(a *app.App) string
+	// Archs are the supported target archs.
+	Archs []app.ArchType
+	// DebugTool is the name of the default debug tool, such as dlv or gdbserver.
+	// Empty means the debugger is built in the runtime.
+	DebugTool string
+	// OneShotAttach indicates the attach command exits once the debugger is activated
+	// in the running process, instead of running as the debugger.
+	OneShotAttach bool
+	// EntryPrompt is the message asking for the app entry in init,
+	// empty means the app entry is not asked.
+	EntryPrompt string
+	// DefaultEntry returns the app entry suggested to the app, optional.
+	DefaultEntry func(a *app.App) string
+	// EntryOptions returns the app entries found in the working dir to choose from, optional.
+	EntryOptions func(a *app.App) []string
+}
+
+func (m Metadata) name() string {
+	if m.Name != "" {
+		return m.Name
+	}
+	return m.ProgramType.String()
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]Metadata{}
+)
+
+// Register registers the language adaptor of the program type,
+// the later one overrides the former one.
+func Register(m Metadata) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[m.name()] = m
+}
+
+// GetMetadata returns the metadata of the registered language adaptor of the program type name.
+func GetMetadata(name string) (Metadata, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	m, ok := registry[name]
+	return m, ok
+}
+
+// NewLanguageAdaptor returns the registered language adaptor of the program type of the app.
+func NewLanguageAdaptor(a *app.App) (LanguageAdaptor, error) {
+	m, ok := GetMetadata(app.ProgramTypeName(a))
+	if !ok {
+		return nil, fmt.Errorf("unsupported program type %s", app.ProgramTypeName(a))
+	}
+	return m.New(), nil
+}
+
+// ProgramTypeNames returns the program type names of all registered language adaptors,
+// the builtin ones come first.
+func ProgramTypeNames() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	metas := make([]Metadata, 0, len(registry))
+	for _, m := range registry {
+		metas = append(metas, m)
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		if metas[i].ProgramType != metas[j].ProgramType {
+			// plugin provided program types are unspecified.
+			if metas[i].ProgramType == app.ProgramType_PROGRAM_TYPE_UNSPECIFIED {
+				return false
+			}
+			if metas[j].ProgramType == app.ProgramType_PROGRAM_TYPE_UNSPECIFIED {
+				return true
+			}
+			return metas[i].ProgramType < metas[j].ProgramType
+		}
+		return metas[i].name() < metas[j].name()
+	})
+	names := make([]string, 0, len(metas))
+	for _, m := range metas {
+		names = append(names, m.name())
+	}
+	return names
+}
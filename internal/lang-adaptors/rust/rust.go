@@ -19,6 +19,21 @@ func NewRustAdaptor() langadaptors.LanguageAdaptor {
 	return &rust{}
 }
 
+func init() {
+	langadaptors.Register(langadaptors.Metadata{
+		ProgramType: app.ProgramType_RUST,
+		New:         NewRustAdaptor,
+		DefaultBuildCommand: func(a *app.App) string {
+			return "cargo build"
+		},
+		DefaultBuildOutput: func(a *app.App) string {
+			return ""
+		},
+		Archs:     []app.ArchType{app.ArchType_AMD64, app.ArchType_ARM64},
+		DebugTool: "gdbserver",
+	})
+}
+
 func (r *rust) DebugCommand(app_ *app.App) (string, error) {
 	if app_.ProgramType != app.ProgramType_RUST {
 		return "", fmt.Errorf("program type is not rust")
@@ -31,6 +46,21 @@ func (r *rust) DebugCommand(app_ *app.App) (string, error) {
 	), nil
 }
 
+func (r *rust) AttachCommand(app_ *app.App, pid int) (string, error) {
+	if app_.ProgramType != app.ProgramType_RUST {
+		return "", fmt.Errorf("program type is not rust")
+	}
+	return fmt.Sprintf("%s --attach *:%d %d",
+		app_.RemoteConfig.DebugToolPath,
+		app_.RemoteConfig.RemoteDebuggingPort,
+		pid,
+	), nil
+}
+
+func (r *rust) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	return langadaptors.ProbeGDBServer(addr)
+}
+
 func (r *rust) BuildCommand(a *app.App) (string, error) {
 	if a.ProgramType != app.ProgramType_RUST {
 		return "", fmt.Errorf("program type is not rust")
@@ -0,0 +1,117 @@
+package langadaptors
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+var (
+	trimpathRe  = regexp.MustCompile(`(?:^|[\s"'=])-trimpath(?:=true)?(?:$|[\s"'])`)
+	remapRe     = regexp.MustCompile(`--remap-path-prefix[\s=]["']?([^\s"'=]+)=([^\s"']+)`)
+	volumeRe    = regexp.MustCompile(`(?:^|\s)(?:-v|--volume)(?:\s+|=)?["']?([^\s"':]+):([^\s"':]+)`)
+	mountRe     = regexp.MustCompile(`--mount[\s=]["']?([^\s"']+)`)
+	containerRe = regexp.MustCompile(`(?:^|[\s;&|(])(?:docker|podman|nerdctl)\s`)
+)
+
+// SourceMappings returns the source mappings of the app,
+// they are derived from the build command if not configured.
+func SourceMappings(a *app.App) []*app.SourceMapping {
+	if len(a.GetLocalConfig().GetSourceMappings()) > 0 {
+		return a.LocalConfig.SourceMappings
+	}
+	return DeriveSourceMappings(a)
+}
+
+// DeriveSourceMappings derives the source mappings from the build command of the app,
+// such as -trimpath of go, --remap-path-prefix of rust and the volumes of docker build.
+func DeriveSourceMappings(a *app.App) []*app.SourceMapping {
+	adaptor, err := NewLanguageAdaptor(a)
+	if err != nil {
+		return nil
+	}
+	cmd, err := adaptor.BuildCommand(a)
+	if err != nil {
+		log.Debugf("get build command of %s failed: %v", a.Name, err)
+		return nil
+	}
+	return parseSourceMappings(a.LocalConfig.WorkingDir, cmd, goModulePath(a.LocalConfig.WorkingDir))
+}
+
+func parseSourceMappings(workingDir, command, modulePath string) []*app.SourceMapping {
+	// -trimpath records the module path instead of the file system path.
+	if modulePath != "" && trimpathRe.MatchString(command) {
+		return []*app.SourceMapping{{LocalPath: workingDir, RemotePath: modulePath}}
+	}
+	var mappings []*app.SourceMapping
+	add := func(local, remote string) {
+		local = resolveLocalPath(workingDir, local)
+		// only the mounts of the project sources matter.
+		if local != workingDir && !strings.HasPrefix(local, workingDir+"/") {
+			return
+		}
+		if local == remote {
+			return
+		}
+		mappings = append(mappings, &app.SourceMapping{LocalPath: local, RemotePath: remote})
+	}
+	for _, m := range remapRe.FindAllStringSubmatch(command, -1) {
+		add(m[1], m[2])
+	}
+	if containerRe.MatchString(command) {
+		for _, m := range volumeRe.FindAllStringSubmatch(command, -1) {
+			add(m[1], m[2])
+		}
+		for _, m := range mountRe.FindAllStringSubmatch(command, -1) {
+			var source, target string
+			for _, kv := range strings.Split(m[1], ",") {
+				k, v, _ := strings.Cut(kv, "=")
+				switch k {
+				case "source", "src":
+					source = v
+				case "target", "dst", "destination":
+					target = v
+				}
+			}
+			if source != "" && target != "" {
+				add(source, target)
+			}
+		}
+	}
+	return mappings
+}
+
+func resolveLocalPath(workingDir, p string) string {
+	for _, pwd := range []string{"$(pwd)", "${PWD}", "$PWD", "`pwd`"} {
+		p = strings.Replace(p, pwd, workingDir, 1)
+	}
+	if strings.HasPrefix(p, "~") {
+		// the home dir is never in the project.
+		return p
+	}
+	if !path.IsAbs(p) {
+		p = path.Join(workingDir, p)
+	}
+	return path.Clean(p)
+}
+
+func goModulePath(workingDir string) string {
+	f, err := os.Open(path.Join(workingDir, "go.mod"))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "module ") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), `"`)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package langadaptors
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+func TestParseSourceMappings(t *testing.T) {
+	tests := []struct {
+		name       string
+		command    string
+		modulePath string
+		want       []*app.SourceMapping
+	}{
+		{
+			name:    "plain go build",
+			command: "go build -o out ./cmd/app",
+		},
+		{
+			name:       "go build with trimpath",
+			command:    "CGO_ENABLED=0 go build -trimpath -o out ./cmd/app",
+			modulePath: "github.com/foo/bar",
+			want:       []*app.SourceMapping{{LocalPath: "/work", RemotePath: "github.com/foo/bar"}},
+		},
+		{
+			name:       "trimpath in GOFLAGS",
+			command:    `GOFLAGS="-trimpath" go build -o out .`,
+			modulePath: "github.com/foo/bar",
+			want:       []*app.SourceMapping{{LocalPath: "/work", RemotePath: "github.com/foo/bar"}},
+		},
+		{
+			name:    "rust remap path prefix",
+			command: `RUSTFLAGS="--remap-path-prefix=/work=/build" cargo build`,
+			want:    []*app.SourceMapping{{LocalPath: "/work", RemotePath: "/build"}},
+		},
+		{
+			name:    "docker volume of pwd",
+			command: "docker run --rm -v $(pwd):/src -v ~/.cargo:/root/.cargo -w /src rust cargo build",
+			want:    []*app.SourceMapping{{LocalPath: "/work", RemotePath: "/src"}},
+		},
+		{
+			name:    "docker mount of relative path",
+			command: "docker run --rm --mount type=bind,source=./pkg,target=/go/src/pkg golang go build",
+			want:    []*app.SourceMapping{{LocalPath: "/work/pkg", RemotePath: "/go/src/pkg"}},
+		},
+		{
+			name:    "volume flag without container",
+			command: "make -v build OUT=a:b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseSourceMappings("/work", tt.command, tt.modulePath)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSourceMappings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package debugpy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/miragedebug/miragedebug/config"
+	"github.com/miragedebug/miragedebug/pkg/log"
+	"github.com/miragedebug/miragedebug/pkg/shell"
+)
+
+const (
+	debugpyVersion = "1.8.0"
+)
+
+func defaultDebugpyRoot() string {
+	return path.Join(config.GetConfigRootPath(), "debug-tools", "debugpy-"+debugpyVersion)
+}
+
+func findWheel(root string) string {
+	// the pure python wheel works for all archs.
+	files, _ := filepath.Glob(path.Join(root, fmt.Sprintf("debugpy-%s-*none-any.whl", debugpyVersion)))
+	if len(files) == 0 {
+		return ""
+	}
+	return files[0]
+}
+
+// InitOrLoadDebugpy downloads or loads the cached debugpy wheel.
+func InitOrLoadDebugpy(cmds []string) (string, error) {
+	root := defaultDebugpyRoot()
+	if f := findWheel(root); f != "" {
+		return f, nil
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return "", err
+	}
+	commands := cmds
+	if len(commands) == 0 {
+		commands = []string{
+			fmt.Sprintf("pip3 download --no-deps --only-binary=:all: --platform any --python-version 3 --implementation py -d %s debugpy==%s", root, debugpyVersion),
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	if out, errOut, err := shell.ExecuteCommands(ctx, commands); err != nil {
+		log.Errorf("Failed to install debugpy: %v, stdout: %s, stderr: %s", err, out, errOut)
+		return "", err
+	}
+	f := findWheel(root)
+	if f == "" {
+		return "", fmt.Errorf("debugpy wheel not found in %s", root)
+	}
+	return f, nil
+}
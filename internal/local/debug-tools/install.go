@@ -11,17 +11,17 @@ import (
 	"github.com/miragedebug/miragedebug/api/app"
 	"github.com/miragedebug/miragedebug/internal/kube"
 	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
-	"github.com/miragedebug/miragedebug/internal/lang-adaptors/golang"
-	"github.com/miragedebug/miragedebug/internal/lang-adaptors/rust"
+	_ "github.com/miragedebug/miragedebug/internal/lang-adaptors/all"
 )
 
-func InstallPodDebugTool(ctx context.Context, app_ *app.App, config *rest.Config, podName string) error {
+// InstallPodDebugTool installs the debug tool into the container of the pod.
+func InstallPodDebugTool(ctx context.Context, app_ *app.App, config *rest.Config, podName string, container string) error {
 	if app_.LocalConfig.DebugToolBuilder.Type == app.DebugToolType_REMOTE {
 		out, outErr, err := kube.ExecutePodCmd(ctx,
 			config,
 			app_.RemoteRuntime.Namespace,
 			podName,
-			app_.RemoteRuntime.ContainerName,
+			container,
 			strings.Join(app_.LocalConfig.DebugToolBuilder.BuildCommands, "\n"),
 			nil)
 		if err != nil {
@@ -29,25 +29,24 @@ func InstallPodDebugTool(ctx context.Context, app_ *app.App, config *rest.Config
 		}
 		return nil
 	}
-	var langAdaptor langadaptors.LanguageAdaptor
-	switch app_.ProgramType {
-	case app.ProgramType_GO:
-		langAdaptor = golang.NewGolangAdaptor()
-	case app.ProgramType_RUST:
-		langAdaptor = rust.NewRustAdaptor()
-	default:
-		return fmt.Errorf("not implemented")
+	langAdaptor, err := langadaptors.NewLanguageAdaptor(app_)
+	if err != nil {
+		return err
 	}
 	f, err := langAdaptor.LocalDebugToolInstall(app_)
 	if err != nil {
 		return err
 	}
+	if f == "" {
+		// the debugger is built in the runtime, nothing to install.
+		return nil
+	}
 	app_.LocalConfig.DebugToolBuilder.LocalDest = f
 	err = kube.CopyLocalFileToPod(ctx,
 		config,
 		app_.RemoteRuntime.Namespace,
 		podName,
-		app_.RemoteRuntime.ContainerName,
+		container,
 		app_.LocalConfig.DebugToolBuilder.LocalDest,
 		path.Base(app_.RemoteConfig.DebugToolPath),
 		path.Dir(app_.RemoteConfig.DebugToolPath))
@@ -58,7 +57,7 @@ func InstallPodDebugTool(ctx context.Context, app_ *app.App, config *rest.Config
 		config,
 		app_.RemoteRuntime.Namespace,
 		podName,
-		app_.RemoteRuntime.ContainerName,
+		container,
 		fmt.Sprintf("chmod +x %s", app_.RemoteConfig.DebugToolPath),
 		nil,
 	)
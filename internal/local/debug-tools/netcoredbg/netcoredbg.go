@@ -0,0 +1,69 @@
+package netcoredbg
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/config"
+	"github.com/miragedebug/miragedebug/pkg/shell"
+)
+
+const netcoredbgVersion = "3.1.0-1031"
+
+func defaultNetcoredbgRoot() string {
+	return path.Join(config.GetConfigRootPath(), "debug-tools", "netcoredbg-"+netcoredbgVersion)
+}
+
+func downloadFile(url, filename string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s failed: %s", url, resp.Status)
+	}
+	os.MkdirAll(path.Dir(filename), 0755)
+	out, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// InitOrLoadNetcoredbg downloads or loads the cached netcoredbg tarball for the given architecture.
+// netcoredbg is shipped with its shared libraries, so the tarball is extracted in the container.
+func InitOrLoadNetcoredbg(arch app.ArchType, cmds []string) (string, error) {
+	f := path.Join(defaultNetcoredbgRoot(), "netcoredbg-linux-"+app.ToSystemArch(arch)+".tar.gz")
+	if _, err := os.Stat(f); err == nil {
+		return f, nil
+	}
+	if len(cmds) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+		if out, errOut, err := shell.ExecuteCommands(ctx, cmds); err != nil {
+			return "", fmt.Errorf("install netcoredbg failed: %v, stdout: %s, stderr: %s", err, out, errOut)
+		}
+		return f, nil
+	}
+	switch arch {
+	case app.ArchType_AMD64, app.ArchType_ARM64:
+		url := fmt.Sprintf("https://github.com/Samsung/netcoredbg/releases/download/%s/netcoredbg-linux-%s.tar.gz",
+			netcoredbgVersion, app.ToSystemArch(arch))
+		if err := downloadFile(url, f); err != nil {
+			os.Remove(f)
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unsupported arch %s", arch)
+	}
+	return f, nil
+}
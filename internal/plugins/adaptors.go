@@ -0,0 +1,85 @@
+package plugins
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	pluginapi "github.com/miragedebug/miragedebug/api/plugin"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+)
+
+// languageAdaptor is the LanguageAdaptor provided by a plugin.
+type languageAdaptor struct {
+	client *client
+}
+
+func (l *languageAdaptor) BuildCommand(a *app.App) (string, error) {
+	resp, err := l.client.BuildCommand(context.Background(), a)
+	if err != nil {
+		return "", err
+	}
+	return resp.Command, nil
+}
+
+func (l *languageAdaptor) LocalDebugToolInstall(a *app.App) (string, error) {
+	resp, err := l.client.LocalDebugToolInstall(context.Background(), a)
+	if err != nil {
+		return "", err
+	}
+	return resp.Path, nil
+}
+
+func (l *languageAdaptor) DebugCommand(app_ *app.App) (string, error) {
+	resp, err := l.client.DebugCommand(context.Background(), app_)
+	if err != nil {
+		return "", err
+	}
+	return resp.Command, nil
+}
+
+func (l *languageAdaptor) AttachCommand(app_ *app.App, pid int) (string, error) {
+	resp, err := l.client.AttachCommand(context.Background(), &pluginapi.AttachRequest{
+		App: app_,
+		Pid: int32(pid),
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Command, nil
+}
+
+// ReadinessProbe probes through the forwarded address only, the executor is not available to plugins.
+func (l *languageAdaptor) ReadinessProbe(app_ *app.App, addr string, executor langadaptors.RemotePodShellExecutor) error {
+	_, err := l.client.ReadinessProbe(context.Background(), &pluginapi.ProbeRequest{
+		App:     app_,
+		Address: addr,
+	})
+	// plugins built before ReadinessProbe was added are taken as ready.
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	return err
+}
+
+// ideAdaptor is the IDEAdaptor provided by a plugin.
+// The plugin is launched in the working dir of the CLI, so the IDE config is written to the project.
+type ideAdaptor struct {
+	client *client
+}
+
+func (i *ideAdaptor) PrepareLaunch(a *app.App) error {
+	_, err := i.client.PrepareLaunch(context.Background(), a)
+	return err
+}
+
+func (i *ideAdaptor) Cleanup(a *app.App) error {
+	_, err := i.client.Cleanup(context.Background(), a)
+	// plugins built before Cleanup was added create nothing to clean.
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	return err
+}
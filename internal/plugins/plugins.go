@@ -0,0 +1,191 @@
+package plugins
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	pluginapi "github.com/miragedebug/miragedebug/api/plugin"
+	ideadapotors "github.com/miragedebug/miragedebug/internal/ide-adapotors"
+	langadaptors "github.com/miragedebug/miragedebug/internal/lang-adaptors"
+	"github.com/miragedebug/miragedebug/pkg/log"
+	"github.com/miragedebug/miragedebug/pkg/plugin"
+)
+
+const handshakeTimeout = time.Second * 10
+
+type client struct {
+	path  string
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	conn  *grpc.ClientConn
+	pluginapi.PluginClient
+}
+
+var (
+	clientsLock sync.Mutex
+	clients     []*client
+)
+
+// Load launches the plugin executables in dir, and registers the languages and IDE types they provide.
+// A plugin failing to launch is skipped.
+func Load(dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("read plugins dir %s failed: %v", dir, err)
+		}
+		return
+	}
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+			continue
+		}
+		p := filepath.Join(dir, e.Name())
+		c, err := launch(p)
+		if err != nil {
+			log.Errorf("launch plugin %s failed: %v", p, err)
+			continue
+		}
+		if err := c.register(); err != nil {
+			log.Errorf("register plugin %s failed: %v", p, err)
+			c.kill()
+			continue
+		}
+		clientsLock.Lock()
+		clients = append(clients, c)
+		clientsLock.Unlock()
+	}
+}
+
+// Shutdown kills all launched plugins.
+func Shutdown() {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	for _, c := range clients {
+		c.kill()
+	}
+	clients = nil
+}
+
+func launch(p string) (*client, error) {
+	cmd := exec.Command(p)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", plugin.MagicCookieKey, plugin.MagicCookieValue))
+	cmd.Stderr = os.Stderr
+	// the plugin exits when stdin is closed, even if MirageDebug is killed.
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	c := &client{path: p, cmd: cmd, stdin: stdin}
+	lines := make(chan string, 1)
+	go func() {
+		reader := bufio.NewReader(stdout)
+		line, _ := reader.ReadString('\n')
+		lines <- line
+		// drain the output of the plugin, or it blocks on writing.
+		io.Copy(os.Stdout, reader)
+	}()
+	var line string
+	select {
+	case line = <-lines:
+	case <-time.After(handshakeTimeout):
+		c.kill()
+		return nil, fmt.Errorf("wait for handshake timeout")
+	}
+	h, err := plugin.ParseHandshake(line)
+	if err != nil {
+		c.kill()
+		return nil, err
+	}
+	target := h.Address
+	if h.Network == "unix" {
+		target = "unix://" + h.Address
+	}
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		c.kill()
+		return nil, err
+	}
+	c.conn = conn
+	c.PluginClient = pluginapi.NewPluginClient(conn)
+	return c, nil
+}
+
+func (c *client) kill() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	c.stdin.Close()
+	if c.cmd.Process != nil {
+		c.cmd.Process.Kill()
+		c.cmd.Wait()
+	}
+}
+
+func (c *client) register() error {
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+	info, err := c.GetInfo(ctx, &app.Empty{})
+	if err != nil {
+		return err
+	}
+	for _, l := range info.Languages {
+		log.Debugf("plugin %s provides language %s", c.path, l.Name)
+		langadaptors.Register(langadaptors.Metadata{
+			// a plugin can override the builtin program type.
+			ProgramType: app.ProgramType(app.ProgramType_value[l.Name]),
+			Name:        l.Name,
+			New: func() langadaptors.LanguageAdaptor {
+				return &languageAdaptor{client: c}
+			},
+			DefaultBuildCommand: func(a *app.App) string {
+				return c.defaults(a).GetBuildCommand()
+			},
+			DefaultBuildOutput: func(a *app.App) string {
+				return c.defaults(a).GetBuildOutput()
+			},
+			Archs:         l.Archs,
+			DebugTool:     l.DebugTool,
+			OneShotAttach: l.OneShotAttach,
+			EntryPrompt:   l.EntryPrompt,
+			DefaultEntry: func(a *app.App) string {
+				return c.defaults(a).GetAppEntry()
+			},
+		})
+	}
+	for _, name := range info.IdeTypes {
+		log.Debugf("plugin %s provides IDE type %s", c.path, name)
+		ideadapotors.Register(name, func() ideadapotors.IDEAdaptor {
+			return &ideAdaptor{client: c}
+		})
+	}
+	return nil
+}
+
+func (c *client) defaults(a *app.App) *pluginapi.Defaults {
+	d, err := c.GetDefaults(context.Background(), a)
+	if err != nil {
+		log.Errorf("get defaults from plugin %s failed: %v", c.path, err)
+		return nil
+	}
+	return d
+}
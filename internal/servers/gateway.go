@@ -86,6 +86,7 @@ func NewGateway(ctx context.Context, conn *grpc.ClientConn,
 	registerFuncs ...func(ctx context.Context, serveMux *runtime.ServeMux, clientConn *grpc.ClientConn) error) (http.Handler, error) {
 	gw := runtime.NewServeMux(
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
+		runtime.WithMarshalerOption(mimeEventStream, newSSEMarshaler()),
 	)
 	for _, f := range registerFuncs {
 		if err := f(ctx, gw, conn); err != nil {
@@ -0,0 +1,44 @@
+package servers
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const mimeEventStream = "text/event-stream"
+
+// sseMarshaler writes the responses of the streaming RPCs as server-sent events,
+// it is used when the request accepts text/event-stream, such as EventSource of browsers.
+type sseMarshaler struct {
+	runtime.JSONPb
+}
+
+func newSSEMarshaler() *sseMarshaler {
+	return &sseMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+func (m *sseMarshaler) ContentType(_ interface{}) string {
+	return mimeEventStream
+}
+
+func (m *sseMarshaler) Marshal(v interface{}) ([]byte, error) {
+	bs, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), bs...), nil
+}
+
+// Delimiter ends an event.
+func (m *sseMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
@@ -0,0 +1,57 @@
+package workloads
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+func init() {
+	Register(workloadTypeGVKs[app.WorkloadType_DAEMONSET], func(config *rest.Config) (WorkloadAccessor, error) {
+		client, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		return &daemonSetAccessor{kubeclient: client}, nil
+	})
+}
+
+type daemonSetAccessor struct {
+	kubeclient kubernetes.Interface
+}
+
+func (d *daemonSetAccessor) GetTemplate(ctx context.Context, rt *app.RemoteRuntime) (*corev1.PodTemplateSpec, error) {
+	ds, err := d.kubeclient.AppsV1().DaemonSets(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &ds.Spec.Template, nil
+}
+
+func (d *daemonSetAccessor) SetTemplate(ctx context.Context, rt *app.RemoteRuntime, tmpl corev1.PodTemplateSpec) error {
+	ds, err := d.kubeclient.AppsV1().DaemonSets(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	ds.Spec.Template = tmpl
+	_, err = d.kubeclient.AppsV1().DaemonSets(rt.Namespace).Update(ctx, ds, metav1.UpdateOptions{})
+	return err
+}
+
+func (d *daemonSetAccessor) ListPods(ctx context.Context, rt *app.RemoteRuntime) ([]corev1.Pod, error) {
+	ds, err := d.kubeclient.AppsV1().DaemonSets(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return listPodsBySelector(ctx, d.kubeclient, rt.Namespace, ds.Spec.Selector)
+}
+
+func (d *daemonSetAccessor) Scale(ctx context.Context, rt *app.RemoteRuntime, replicas int32) error {
+	return fmt.Errorf("daemonset %s/%s can not be scaled", rt.Namespace, rt.WorkloadName)
+}
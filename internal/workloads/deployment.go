@@ -0,0 +1,80 @@
+package workloads
+
+import (
+	"context"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+func init() {
+	Register(workloadTypeGVKs[app.WorkloadType_DEPLOYMENT], func(config *rest.Config) (WorkloadAccessor, error) {
+		client, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		return &deploymentAccessor{kubeclient: client}, nil
+	})
+}
+
+type deploymentAccessor struct {
+	kubeclient kubernetes.Interface
+}
+
+func (d *deploymentAccessor) GetTemplate(ctx context.Context, rt *app.RemoteRuntime) (*corev1.PodTemplateSpec, error) {
+	dep, err := d.kubeclient.AppsV1().Deployments(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &dep.Spec.Template, nil
+}
+
+func (d *deploymentAccessor) SetTemplate(ctx context.Context, rt *app.RemoteRuntime, tmpl corev1.PodTemplateSpec) error {
+	dep, err := d.kubeclient.AppsV1().Deployments(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	dep.Spec.Template = tmpl
+	_, err = d.kubeclient.AppsV1().Deployments(rt.Namespace).Update(ctx, dep, metav1.UpdateOptions{})
+	return err
+}
+
+func (d *deploymentAccessor) ListPods(ctx context.Context, rt *app.RemoteRuntime) ([]corev1.Pod, error) {
+	dep, err := d.kubeclient.AppsV1().Deployments(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return listPodsBySelector(ctx, d.kubeclient, rt.Namespace, dep.Spec.Selector)
+}
+
+func (d *deploymentAccessor) Scale(ctx context.Context, rt *app.RemoteRuntime, replicas int32) error {
+	_, err := d.kubeclient.AppsV1().Deployments(rt.Namespace).UpdateScale(ctx, rt.WorkloadName, &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rt.WorkloadName,
+			Namespace: rt.Namespace,
+		},
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: replicas,
+		},
+	}, metav1.UpdateOptions{})
+	return err
+}
+
+func listPodsBySelector(ctx context.Context, client kubernetes.Interface, namespace string, selector *metav1.LabelSelector) ([]corev1.Pod, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: s.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
@@ -0,0 +1,24 @@
+package workloads
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+// DebugLabel is added to the pod template of the workload being debugged,
+// the value is the app name.
+const DebugLabel = "miragedebug.io/debug"
+
+type WorkloadAccessor interface {
+	// GetTemplate returns the pod template of the workload.
+	GetTemplate(ctx context.Context, rt *app.RemoteRuntime) (*corev1.PodTemplateSpec, error)
+	// SetTemplate replaces the pod template of the workload.
+	SetTemplate(ctx context.Context, rt *app.RemoteRuntime, tmpl corev1.PodTemplateSpec) error
+	// ListPods lists the pods selected by the workload.
+	ListPods(ctx context.Context, rt *app.RemoteRuntime) ([]corev1.Pod, error)
+	// Scale changes the replicas of the workload.
+	Scale(ctx context.Context, rt *app.RemoteRuntime, replicas int32) error
+}
@@ -0,0 +1,204 @@
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+const debugJobSuffix = "-mirage-debug"
+
+// labels added to the job template by the job controller,
+// they must be removed before the template is used by another job.
+var jobControllerLabels = []string{
+	"job-name",
+	"controller-uid",
+	batchv1.JobNameLabel,
+	batchv1.ControllerUidLabel,
+}
+
+func init() {
+	newJobAccessor := func(source func(ctx context.Context, client kubernetes.Interface, rt *app.RemoteRuntime) (*batchv1.JobSpec, error)) AccessorFactory {
+		return func(config *rest.Config) (WorkloadAccessor, error) {
+			client, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return nil, err
+			}
+			return &jobAccessor{kubeclient: client, source: source}, nil
+		}
+	}
+	Register(workloadTypeGVKs[app.WorkloadType_JOB], newJobAccessor(func(ctx context.Context, client kubernetes.Interface, rt *app.RemoteRuntime) (*batchv1.JobSpec, error) {
+		job, err := client.BatchV1().Jobs(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &job.Spec, nil
+	}))
+	Register(workloadTypeGVKs[app.WorkloadType_CRONJOB], newJobAccessor(func(ctx context.Context, client kubernetes.Interface, rt *app.RemoteRuntime) (*batchv1.JobSpec, error) {
+		cj, err := client.BatchV1().CronJobs(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &cj.Spec.JobTemplate.Spec, nil
+	}))
+}
+
+// jobAccessor never modifies the original job or cronjob.
+// Setting a debug template creates a one-off debug job from the original job spec,
+// and setting a template without debug label deletes the debug job.
+type jobAccessor struct {
+	kubeclient kubernetes.Interface
+	source     func(ctx context.Context, client kubernetes.Interface, rt *app.RemoteRuntime) (*batchv1.JobSpec, error)
+}
+
+func debugJobName(rt *app.RemoteRuntime) string {
+	name := rt.WorkloadName
+	// job name is used as a label value, which must be no more than 63 characters.
+	if len(name)+len(debugJobSuffix) > 63 {
+		name = name[:63-len(debugJobSuffix)]
+	}
+	return name + debugJobSuffix
+}
+
+func removeJobControllerLabels(tmpl *corev1.PodTemplateSpec) {
+	for _, l := range jobControllerLabels {
+		delete(tmpl.Labels, l)
+	}
+}
+
+func (j *jobAccessor) getDebugJob(ctx context.Context, rt *app.RemoteRuntime) (*batchv1.Job, error) {
+	job, err := j.kubeclient.BatchV1().Jobs(rt.Namespace).Get(ctx, debugJobName(rt), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if job.Status.Active == 0 && (job.Status.Succeeded > 0 || job.Status.Failed > 0) {
+		// the finished debug job can not be debugged anymore, treat it as not existing.
+		return nil, nil
+	}
+	return job, nil
+}
+
+func (j *jobAccessor) GetTemplate(ctx context.Context, rt *app.RemoteRuntime) (*corev1.PodTemplateSpec, error) {
+	job, err := j.getDebugJob(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	if job != nil {
+		tmpl := job.Spec.Template.DeepCopy()
+		removeJobControllerLabels(tmpl)
+		return tmpl, nil
+	}
+	spec, err := j.source(ctx, j.kubeclient, rt)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := spec.Template.DeepCopy()
+	removeJobControllerLabels(tmpl)
+	return tmpl, nil
+}
+
+func (j *jobAccessor) deleteDebugJob(ctx context.Context, rt *app.RemoteRuntime) error {
+	client := j.kubeclient.BatchV1().Jobs(rt.Namespace)
+	propagation := metav1.DeletePropagationBackground
+	err := client.Delete(ctx, debugJobName(rt), metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+	for {
+		_, err := client.Get(ctx, debugJobName(rt), metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return fmt.Errorf("wait for job %s/%s deleted timeout", rt.Namespace, debugJobName(rt))
+		}
+	}
+}
+
+func (j *jobAccessor) SetTemplate(ctx context.Context, rt *app.RemoteRuntime, tmpl corev1.PodTemplateSpec) error {
+	if err := j.deleteDebugJob(ctx, rt); err != nil {
+		return err
+	}
+	if tmpl.Labels[DebugLabel] == "" {
+		return nil
+	}
+	spec, err := j.source(ctx, j.kubeclient, rt)
+	if err != nil {
+		return err
+	}
+	spec = spec.DeepCopy()
+	removeJobControllerLabels(&tmpl)
+	spec.Template = tmpl
+	// the selector is generated by the job controller.
+	spec.Selector = nil
+	spec.ManualSelector = nil
+	// keep the debug pod alive until the debug job is deleted.
+	spec.Parallelism = pointer.Int32(1)
+	spec.Completions = pointer.Int32(1)
+	spec.ActiveDeadlineSeconds = nil
+	spec.TTLSecondsAfterFinished = nil
+	spec.Suspend = nil
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      debugJobName(rt),
+			Namespace: rt.Namespace,
+			Labels:    map[string]string{DebugLabel: tmpl.Labels[DebugLabel]},
+		},
+		Spec: *spec,
+	}
+	_, err = j.kubeclient.BatchV1().Jobs(rt.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	return err
+}
+
+func (j *jobAccessor) ListPods(ctx context.Context, rt *app.RemoteRuntime) ([]corev1.Pod, error) {
+	job, err := j.getDebugJob(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	if job != nil {
+		return listPodsBySelector(ctx, j.kubeclient, rt.Namespace, job.Spec.Selector)
+	}
+	spec, err := j.source(ctx, j.kubeclient, rt)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Selector != nil {
+		return listPodsBySelector(ctx, j.kubeclient, rt.Namespace, spec.Selector)
+	}
+	tmpl := spec.Template.DeepCopy()
+	removeJobControllerLabels(tmpl)
+	podList, err := j.kubeclient.CoreV1().Pods(rt.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(tmpl.Labels).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+func (j *jobAccessor) Scale(ctx context.Context, rt *app.RemoteRuntime, replicas int32) error {
+	return fmt.Errorf("job %s/%s can not be scaled", rt.Namespace, rt.WorkloadName)
+}
@@ -0,0 +1,73 @@
+package workloads
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+// AccessorFactory creates a WorkloadAccessor with the kubeconfig.
+type AccessorFactory func(config *rest.Config) (WorkloadAccessor, error)
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[schema.GroupVersionKind]AccessorFactory{}
+)
+
+var workloadTypeGVKs = map[app.WorkloadType]schema.GroupVersionKind{
+	app.WorkloadType_DEPLOYMENT:  {Group: "apps", Version: "v1", Kind: "Deployment"},
+	app.WorkloadType_DAEMONSET:   {Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	app.WorkloadType_STATEFULSET: {Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	app.WorkloadType_JOB:         {Group: "batch", Version: "v1", Kind: "Job"},
+	app.WorkloadType_CRONJOB:     {Group: "batch", Version: "v1", Kind: "CronJob"},
+}
+
+// Register registers the accessor factory of the given kind,
+// the later one overrides the former one.
+func Register(gvk schema.GroupVersionKind, factory AccessorFactory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[gvk] = factory
+}
+
+// GroupVersionKind returns the kind of the workload in the runtime.
+func GroupVersionKind(rt *app.RemoteRuntime) (schema.GroupVersionKind, error) {
+	if rt.WorkloadType == app.WorkloadType_CUSTOM {
+		if rt.WorkloadApiVersion == "" || rt.WorkloadKind == "" {
+			return schema.GroupVersionKind{}, fmt.Errorf("workload apiVersion and kind are required for custom workload")
+		}
+		gv, err := schema.ParseGroupVersion(rt.WorkloadApiVersion)
+		if err != nil {
+			return schema.GroupVersionKind{}, err
+		}
+		return gv.WithKind(rt.WorkloadKind), nil
+	}
+	gvk, ok := workloadTypeGVKs[rt.WorkloadType]
+	if !ok {
+		return schema.GroupVersionKind{}, fmt.Errorf("unsupported workload type %s", rt.WorkloadType.String())
+	}
+	return gvk, nil
+}
+
+// NewAccessor returns the registered accessor of the workload in the runtime.
+// Custom workloads without a registered accessor are accessed as unstructured objects.
+func NewAccessor(config *rest.Config, rt *app.RemoteRuntime) (WorkloadAccessor, error) {
+	gvk, err := GroupVersionKind(rt)
+	if err != nil {
+		return nil, err
+	}
+	registryLock.RLock()
+	factory, ok := registry[gvk]
+	registryLock.RUnlock()
+	if ok {
+		return factory(config)
+	}
+	if rt.WorkloadType == app.WorkloadType_CUSTOM {
+		return newUnstructuredAccessor(config, gvk)
+	}
+	return nil, fmt.Errorf("no accessor registered for %s", gvk.String())
+}
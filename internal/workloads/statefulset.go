@@ -0,0 +1,153 @@
+package workloads
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/miragedebug/miragedebug/api/app"
+	"github.com/miragedebug/miragedebug/pkg/log"
+)
+
+// statefulSetUpdateStrategyAnnotation keeps the update strategy of the statefulset before debugging.
+// The strategy is OnDelete while debugging, so only the debugged ordinal is recreated with the debug template,
+// the other ordinals keep serving.
+const statefulSetUpdateStrategyAnnotation = "miragedebug.io/original-update-strategy"
+
+func init() {
+	Register(workloadTypeGVKs[app.WorkloadType_STATEFULSET], func(config *rest.Config) (WorkloadAccessor, error) {
+		client, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		return &statefulSetAccessor{kubeclient: client}, nil
+	})
+}
+
+type statefulSetAccessor struct {
+	kubeclient kubernetes.Interface
+}
+
+func statefulSetConverged(sts *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	return sts.Status.ObservedGeneration >= sts.Generation &&
+		sts.Status.UpdatedReplicas == replicas &&
+		sts.Status.CurrentRevision == sts.Status.UpdateRevision
+}
+
+// StatefulSetPodName returns the name of the pod of the debugged ordinal.
+func StatefulSetPodName(rt *app.RemoteRuntime) string {
+	return fmt.Sprintf("%s-%d", rt.WorkloadName, rt.PodOrdinal)
+}
+
+func (s *statefulSetAccessor) GetTemplate(ctx context.Context, rt *app.RemoteRuntime) (*corev1.PodTemplateSpec, error) {
+	sts, err := s.kubeclient.AppsV1().StatefulSets(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &sts.Spec.Template, nil
+}
+
+func (s *statefulSetAccessor) SetTemplate(ctx context.Context, rt *app.RemoteRuntime, tmpl corev1.PodTemplateSpec) error {
+	client := s.kubeclient.AppsV1().StatefulSets(rt.Namespace)
+	sts, err := client.Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	sts.Spec.Template = tmpl
+	if sts.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		strategy, err := json.Marshal(sts.Spec.UpdateStrategy)
+		if err != nil {
+			return err
+		}
+		if sts.Annotations == nil {
+			sts.Annotations = map[string]string{}
+		}
+		sts.Annotations[statefulSetUpdateStrategyAnnotation] = string(strategy)
+		sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+	}
+	if _, err = client.Update(ctx, sts, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	// pods of OnDelete statefulset will not be updated by the controller,
+	// only the debugged ordinal is recreated with the new template, the others keep running.
+	err = s.kubeclient.CoreV1().Pods(rt.Namespace).Delete(ctx, StatefulSetPodName(rt), metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if tmpl.Labels[DebugLabel] != "" {
+		return nil
+	}
+	return s.restoreUpdateStrategy(ctx, rt)
+}
+
+// restoreUpdateStrategy waits for the debugged ordinal recreated with the initial template,
+// and then restores the update strategy saved before debugging.
+// Restoring it earlier may roll the other ordinals, or keep the debugged one below the partition
+// running the debug template.
+func (s *statefulSetAccessor) restoreUpdateStrategy(ctx context.Context, rt *app.RemoteRuntime) error {
+	client := s.kubeclient.AppsV1().StatefulSets(rt.Namespace)
+	ctx, cancel := context.WithTimeout(ctx, time.Second*60)
+	defer cancel()
+	ticker := time.NewTicker(time.Second * 3)
+	defer ticker.Stop()
+	for {
+		sts, err := client.Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		strategy, ok := sts.Annotations[statefulSetUpdateStrategyAnnotation]
+		if !ok {
+			return nil
+		}
+		if statefulSetConverged(sts) {
+			sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{}
+			if err := json.Unmarshal([]byte(strategy), &sts.Spec.UpdateStrategy); err != nil {
+				return fmt.Errorf("invalid update strategy annotation %s: %v", strategy, err)
+			}
+			delete(sts.Annotations, statefulSetUpdateStrategyAnnotation)
+			_, err = client.Update(ctx, sts, metav1.UpdateOptions{})
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Warnf("statefulset %s/%s is still rolling back, update strategy %s will be restored in next rollback",
+				rt.Namespace, rt.WorkloadName, strategy)
+			return nil
+		}
+	}
+}
+
+func (s *statefulSetAccessor) ListPods(ctx context.Context, rt *app.RemoteRuntime) ([]corev1.Pod, error) {
+	sts, err := s.kubeclient.AppsV1().StatefulSets(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return listPodsBySelector(ctx, s.kubeclient, rt.Namespace, sts.Spec.Selector)
+}
+
+func (s *statefulSetAccessor) Scale(ctx context.Context, rt *app.RemoteRuntime, replicas int32) error {
+	_, err := s.kubeclient.AppsV1().StatefulSets(rt.Namespace).UpdateScale(ctx, rt.WorkloadName, &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rt.WorkloadName,
+			Namespace: rt.Namespace,
+		},
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: replicas,
+		},
+	}, metav1.UpdateOptions{})
+	return err
+}
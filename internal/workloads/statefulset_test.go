@@ -0,0 +1,76 @@
+package workloads
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+func TestStatefulSetSetTemplateRecreatesOrdinal(t *testing.T) {
+	objects := []runtime.Object{&appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: pointer.Int32(3),
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: pointer.Int32(2)},
+			},
+		},
+	}}
+	for _, name := range []string{"db-0", "db-1", "db-2"} {
+		objects = append(objects, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+	}
+	client := fake.NewSimpleClientset(objects...)
+	s := &statefulSetAccessor{kubeclient: client}
+	rt := &app.RemoteRuntime{Namespace: "default", WorkloadName: "db", PodOrdinal: 1}
+	tmpl := corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{DebugLabel: "db"}}}
+	if err := s.SetTemplate(context.Background(), rt, tmpl); err != nil {
+		t.Fatal(err)
+	}
+	sts, err := client.AppsV1().StatefulSets("default").Get(context.Background(), "db", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sts.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		t.Errorf("update strategy = %s, want OnDelete", sts.Spec.UpdateStrategy.Type)
+	}
+	pods, err := client.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+	if len(names) != 2 || names[0] != "db-0" || names[1] != "db-2" {
+		t.Errorf("pods = %v, want [db-0 db-2]", names)
+	}
+
+	// converged on the initial template, the saved strategy is restored.
+	sts.Status = appsv1.StatefulSetStatus{ObservedGeneration: sts.Generation, UpdatedReplicas: 3}
+	if _, err := client.AppsV1().StatefulSets("default").Update(context.Background(), sts, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetTemplate(context.Background(), rt, corev1.PodTemplateSpec{}); err != nil {
+		t.Fatal(err)
+	}
+	sts, err = client.AppsV1().StatefulSets("default").Get(context.Background(), "db", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ru := sts.Spec.UpdateStrategy.RollingUpdate
+	if sts.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType || ru == nil || *ru.Partition != 2 {
+		t.Errorf("update strategy = %+v, want the RollingUpdate of partition 2", sts.Spec.UpdateStrategy)
+	}
+	if _, ok := sts.Annotations[statefulSetUpdateStrategyAnnotation]; ok {
+		t.Errorf("annotation %s is not removed", statefulSetUpdateStrategyAnnotation)
+	}
+}
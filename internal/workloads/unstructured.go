@@ -0,0 +1,172 @@
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/miragedebug/miragedebug/api/app"
+)
+
+const defaultTemplatePath = "spec.template"
+
+// unstructuredAccessor accesses any workload which has a pod template by the dynamic client,
+// such as Argo Rollouts, OpenKruise CloneSets or Knative services.
+type unstructuredAccessor struct {
+	kubeclient kubernetes.Interface
+	client     dynamic.Interface
+	resource   schema.GroupVersionResource
+}
+
+func newUnstructuredAccessor(config *rest.Config, gvk schema.GroupVersionKind) (WorkloadAccessor, error) {
+	kubeclient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource of %s: %v", gvk.String(), err)
+	}
+	return &unstructuredAccessor{
+		kubeclient: kubeclient,
+		client:     client,
+		resource:   mapping.Resource,
+	}, nil
+}
+
+func templatePath(rt *app.RemoteRuntime) []string {
+	p := rt.TemplatePath
+	if p == "" {
+		p = defaultTemplatePath
+	}
+	return strings.Split(p, ".")
+}
+
+func (u *unstructuredAccessor) get(ctx context.Context, rt *app.RemoteRuntime) (*unstructured.Unstructured, map[string]interface{}, error) {
+	obj, err := u.client.Resource(u.resource).Namespace(rt.Namespace).Get(ctx, rt.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl, found, err := unstructured.NestedMap(obj.Object, templatePath(rt)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("pod template %s not found in %s %s/%s",
+			strings.Join(templatePath(rt), "."), u.resource.Resource, rt.Namespace, rt.WorkloadName)
+	}
+	return obj, tmpl, nil
+}
+
+func (u *unstructuredAccessor) GetTemplate(ctx context.Context, rt *app.RemoteRuntime) (*corev1.PodTemplateSpec, error) {
+	_, m, err := u.get(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, tmpl); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+func (u *unstructuredAccessor) SetTemplate(ctx context.Context, rt *app.RemoteRuntime, tmpl corev1.PodTemplateSpec) error {
+	obj, origin, err := u.get(ctx, rt)
+	if err != nil {
+		return err
+	}
+	target, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tmpl)
+	if err != nil {
+		return err
+	}
+	// fields of the custom template which are unknown to PodTemplateSpec,
+	// such as containerConcurrency of knative, are lost in the conversion.
+	typed := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(origin, typed); err != nil {
+		return err
+	}
+	known, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		return err
+	}
+	preserveUnknownFields(origin, known, target)
+	if err := unstructured.SetNestedMap(obj.Object, target, templatePath(rt)...); err != nil {
+		return err
+	}
+	_, err = u.client.Resource(u.resource).Namespace(rt.Namespace).Update(ctx, obj, metav1.UpdateOptions{})
+	return err
+}
+
+// preserveUnknownFields copies the fields exist in origin but not in known to target.
+func preserveUnknownFields(origin, known, target map[string]interface{}) {
+	for k, v := range origin {
+		kv, ok := known[k]
+		if !ok {
+			target[k] = v
+			continue
+		}
+		om, ok1 := v.(map[string]interface{})
+		km, ok2 := kv.(map[string]interface{})
+		tm, ok3 := target[k].(map[string]interface{})
+		if ok1 && ok2 && ok3 {
+			preserveUnknownFields(om, km, tm)
+		}
+	}
+}
+
+func (u *unstructuredAccessor) ListPods(ctx context.Context, rt *app.RemoteRuntime) ([]corev1.Pod, error) {
+	obj, m, err := u.get(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	var selector labels.Selector
+	if s, found, _ := unstructured.NestedMap(obj.Object, "spec", "selector"); found {
+		ls := &metav1.LabelSelector{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(s, ls); err != nil {
+			return nil, err
+		}
+		selector, err = metav1.LabelSelectorAsSelector(ls)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		l, _, _ := unstructured.NestedStringMap(m, "metadata", "labels")
+		selector = labels.SelectorFromSet(l)
+	}
+	podList, err := u.kubeclient.CoreV1().Pods(rt.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+func (u *unstructuredAccessor) Scale(ctx context.Context, rt *app.RemoteRuntime, replicas int32) error {
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	_, err := u.client.Resource(u.resource).Namespace(rt.Namespace).Patch(ctx, rt.WorkloadName, types.MergePatchType, []byte(patch), metav1.PatchOptions{}, "scale")
+	return err
+}
@@ -0,0 +1,49 @@
+package workloads
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPreserveUnknownFields(t *testing.T) {
+	origin := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app": "foo"},
+		},
+		"spec": map[string]interface{}{
+			"containerConcurrency": int64(10),
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":    "foo",
+					"image":   "foo:v1",
+					"command": []interface{}{"/foo"},
+				},
+			},
+		},
+	}
+	typed := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(origin, typed); err != nil {
+		t.Fatal(err)
+	}
+	known, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	typed.Spec.Containers[0].Command = []string{"/bin/sh"}
+	target, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	preserveUnknownFields(origin, known, target)
+	spec := target["spec"].(map[string]interface{})
+	if spec["containerConcurrency"] != int64(10) {
+		t.Errorf("except containerConcurrency preserved, but got %v", spec["containerConcurrency"])
+	}
+	command := spec["containers"].([]interface{})[0].(map[string]interface{})["command"]
+	if !reflect.DeepEqual(command, []interface{}{"/bin/sh"}) {
+		t.Errorf("except command /bin/sh, but got %v", command)
+	}
+}
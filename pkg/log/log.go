@@ -23,6 +23,9 @@ var (
 	Fatalf    = log.Fatalf
 	WithField = log.WithField
 	AccessLog = log.New()
+
+	// RegisterExitHandler registers a handler run before Fatal exits.
+	RegisterExitHandler = log.RegisterExitHandler
 )
 
 func init() {
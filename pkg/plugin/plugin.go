@@ -0,0 +1,109 @@
+// Package plugin serves the out-of-process language and IDE adaptors of MirageDebug.
+//
+// Plugin executables are discovered in ~/.mirage/plugins and launched by MirageDebug.
+// A plugin serves the Plugin gRPC service on a local address and writes the handshake
+// line to stdout, like hashicorp go-plugin:
+//
+//	CORE-PROTOCOL-VERSION|APP-PROTOCOL-VERSION|NETWORK-TYPE|NETWORK-ADDR|PROTOCOL
+//
+// such as "1|1|tcp|127.0.0.1:1234|grpc". The plugin should exit when its stdin is closed.
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	pluginapi "github.com/miragedebug/miragedebug/api/plugin"
+)
+
+const (
+	// MagicCookieKey and MagicCookieValue make sure the plugin is launched by MirageDebug,
+	// instead of being executed directly by users.
+	MagicCookieKey   = "MIRAGE_DEBUG_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "6f1e3c7a9b2d4e58a0c1d2e3f4a5b6c7"
+	// CoreProtocolVersion is the version of the handshake.
+	CoreProtocolVersion = 1
+	// ProtocolVersion is the version of the Plugin gRPC service.
+	ProtocolVersion = 1
+	protocolGRPC    = "grpc"
+)
+
+// Handshake is the handshake line written by the plugin.
+type Handshake struct {
+	CoreProtocolVersion int
+	ProtocolVersion     int
+	Network             string
+	Address             string
+	Protocol            string
+}
+
+func (h Handshake) String() string {
+	return fmt.Sprintf("%d|%d|%s|%s|%s", h.CoreProtocolVersion, h.ProtocolVersion, h.Network, h.Address, h.Protocol)
+}
+
+// ParseHandshake parses and validates the handshake line written by the plugin.
+func ParseHandshake(line string) (Handshake, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return Handshake{}, fmt.Errorf("invalid handshake %q", line)
+	}
+	core, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Handshake{}, fmt.Errorf("invalid core protocol version %q", parts[0])
+	}
+	if core != CoreProtocolVersion {
+		return Handshake{}, fmt.Errorf("unsupported core protocol version %d, want %d", core, CoreProtocolVersion)
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Handshake{}, fmt.Errorf("invalid protocol version %q", parts[1])
+	}
+	if version != ProtocolVersion {
+		return Handshake{}, fmt.Errorf("unsupported protocol version %d, want %d", version, ProtocolVersion)
+	}
+	if parts[2] != "tcp" && parts[2] != "unix" {
+		return Handshake{}, fmt.Errorf("unsupported network %q", parts[2])
+	}
+	if parts[4] != protocolGRPC {
+		return Handshake{}, fmt.Errorf("unsupported protocol %q", parts[4])
+	}
+	return Handshake{
+		CoreProtocolVersion: core,
+		ProtocolVersion:     version,
+		Network:             parts[2],
+		Address:             parts[3],
+		Protocol:            parts[4],
+	}, nil
+}
+
+// Serve serves the plugin until stdin is closed by MirageDebug.
+func Serve(impl pluginapi.PluginServer) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return fmt.Errorf("this is a MirageDebug plugin, put it in ~/.mirage/plugins instead of executing it directly")
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer()
+	pluginapi.RegisterPluginServer(server, impl)
+	go func() {
+		// MirageDebug exits or kills the plugin.
+		io.Copy(io.Discard, os.Stdin)
+		server.Stop()
+	}()
+	fmt.Fprintln(os.Stdout, Handshake{
+		CoreProtocolVersion: CoreProtocolVersion,
+		ProtocolVersion:     ProtocolVersion,
+		Network:             "tcp",
+		Address:             l.Addr().String(),
+		Protocol:            protocolGRPC,
+	}.String())
+	return server.Serve(l)
+}
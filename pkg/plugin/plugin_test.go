@@ -0,0 +1,53 @@
+package plugin
+
+import (
+	"testing"
+)
+
+func TestParseHandshake(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    Handshake
+		wantErr bool
+	}{
+		{
+			name: "tcp",
+			line: "1|1|tcp|127.0.0.1:1234|grpc\n",
+			want: Handshake{
+				CoreProtocolVersion: 1,
+				ProtocolVersion:     1,
+				Network:             "tcp",
+				Address:             "127.0.0.1:1234",
+				Protocol:            "grpc",
+			},
+		},
+		{
+			name:    "too few parts",
+			line:    "1|1|tcp|127.0.0.1:1234",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported protocol version",
+			line:    "1|2|tcp|127.0.0.1:1234|grpc",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported protocol",
+			line:    "1|1|tcp|127.0.0.1:1234|netrpc",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHandshake(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseHandshake() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseHandshake() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}